@@ -32,18 +32,64 @@ type AccountSpec struct {
 	// MonitorDefaults sets default values for monitors in this account.
 	//+optional
 	MonitorDefaults *MonitorDefaults `json:"monitorDefaults,omitzero"`
+
+	// AllowedNamespaces restricts which namespaces may use this Account.
+	// If not set, resources in any namespace may use it.
+	//+optional
+	AllowedNamespaces *metav1.LabelSelector `json:"allowedNamespaces,omitempty"`
+
+	// GarbageCollection removes Pulsetic monitors created by this cluster that no longer have a Monitor resource.
+	// Requires the operator to run with --cluster-name.
+	// Only monitors created with this Account's API key are collected. Monitors created with an
+	// AccountBinding's apiKeySecretRef are in a different Pulsetic account and must be cleaned up manually.
+	//+optional
+	GarbageCollection *GarbageCollection `json:"garbageCollection,omitempty"`
+}
+
+// GarbageCollection configures removal of orphaned Pulsetic monitors.
+type GarbageCollection struct {
+	// Enabled turns on periodic garbage collection.
+	//+kubebuilder:default:=true
+	Enabled bool `json:"enabled"`
+
+	// DryRun reports orphaned monitors with events instead of deleting them.
+	//+kubebuilder:default:=false
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Interval is the time between garbage collection runs.
+	//+kubebuilder:default:="1h"
+	Interval *metav1.Duration `json:"interval,omitempty"`
 }
 
 // AccountStatus defines the observed state of Account.
 type AccountStatus struct {
+	// Ready mirrors the status of the Ready condition.
+	//
+	// Deprecated: use the Ready condition instead. This field will be removed in a future release.
+	//+optional
 	Ready bool `json:"ready"`
+
+	// ObservedGeneration is the most recent generation observed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// OrphanedMonitors is the number of orphaned Pulsetic monitors found by the last garbage collection run.
+	OrphanedMonitors int32 `json:"orphanedMonitors,omitempty"`
+
+	// LastGarbageCollection is the time of the last garbage collection run.
+	LastGarbageCollection *metav1.Time `json:"lastGarbageCollection,omitempty"`
+
+	// Conditions represent the latest available observations of the Account's state.
+	//+listType=map
+	//+listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:resource:scope=Cluster
-//+kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready"
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
 //+kubebuilder:printcolumn:name="Default",type="boolean",JSONPath=".spec.isDefault"
+//+kubebuilder:printcolumn:name="Orphans",type="integer",JSONPath=".status.orphanedMonitors",priority=1
 //+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Account is the Schema for the accounts API.
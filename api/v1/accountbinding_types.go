@@ -0,0 +1,70 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccountBindingSpec defines the desired state of AccountBinding.
+type AccountBindingSpec struct {
+	// AccountRef references the cluster Account that this namespace uses.
+	AccountRef corev1.LocalObjectReference `json:"accountRef"`
+
+	// IsDefault makes the referenced Account the default for resources in this namespace,
+	// taking precedence over the cluster default Account.
+	//+kubebuilder:default:=false
+	IsDefault bool `json:"isDefault,omitempty"`
+
+	// APIKeySecretRef references a secret in this namespace that contains the Pulsetic API key
+	// used for resources in this namespace instead of the Account's.
+	//+optional
+	APIKeySecretRef *corev1.SecretKeySelector `json:"apiKeySecretRef,omitempty"`
+
+	// MonitorDefaults overrides the Account's monitor defaults for resources in this namespace.
+	// Fields that are not set fall back to the Account's defaults.
+	//+optional
+	MonitorDefaults *MonitorDefaults `json:"monitorDefaults,omitzero"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:printcolumn:name="Account",type="string",JSONPath=".spec.accountRef.name"
+//+kubebuilder:printcolumn:name="Default",type="boolean",JSONPath=".spec.isDefault"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// AccountBinding is the Schema for the accountbindings API.
+// It binds a namespace to a cluster Account and sets namespace-level defaults.
+type AccountBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AccountBindingSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AccountBindingList contains a list of AccountBinding.
+type AccountBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountBinding `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AccountBinding{}, &AccountBindingList{})
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+const (
+	// ConditionReady is true when the resource has been fully reconciled.
+	ConditionReady = "Ready"
+
+	// ConditionSynced is true when the Pulsetic monitor matches the spec.
+	ConditionSynced = "Synced"
+
+	// ConditionAccountResolved is true when the Account and its API key were found.
+	ConditionAccountResolved = "AccountResolved"
+
+	// ConditionUp is true when Pulsetic reports the monitored site as online.
+	ConditionUp = "Up"
+
+	// ConditionDegraded is true when the monitored site is up but has a non-fatal problem.
+	ConditionDegraded = "Degraded"
+
+	// ConditionDrifted is true when the Pulsetic monitor was changed outside of the operator.
+	ConditionDrifted = "Drifted"
+
+	// ConditionInvalidSpec is true when Pulsetic rejected the spec with validation errors.
+	// The monitor is not retried until the spec changes.
+	ConditionInvalidSpec = "InvalidSpec"
+
+	// ConditionConflict is true when the Pulsetic monitor is already managed by another Monitor.
+	ConditionConflict = "Conflict"
+
+	// ConditionCertificateExpiring is true when the SSL certificate is invalid or close to expiring.
+	ConditionCertificateExpiring = "CertificateExpiring"
+
+	// ConditionRegionDown is true when some, but not all, check nodes report the monitored site as offline.
+	ConditionRegionDown = "RegionDown"
+
+	// ConditionLighthouseBelowThreshold is true when a Lighthouse score is below its configured minimum.
+	ConditionLighthouseBelowThreshold = "LighthouseBelowThreshold"
+)
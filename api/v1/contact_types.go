@@ -0,0 +1,143 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ContactSpec defines the desired state of Contact.
+type ContactSpec struct {
+	// Interval defines the reconcile interval.
+	//+kubebuilder:default:="24h"
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Prune enables garbage collection.
+	//+kubebuilder:default:=true
+	Prune bool `json:"prune,omitempty"`
+
+	// Account references this object's Account. If not specified, the default will be used.
+	Account corev1.LocalObjectReference `json:"account,omitempty"`
+
+	// Contact configures the Pulsetic notification channel.
+	Contact ContactValues `json:"contact"`
+}
+
+//+kubebuilder:validation:Enum=Email;Slack;Webhook;Telegram
+
+type ContactType string
+
+const (
+	ContactTypeEmail    ContactType = "Email"
+	ContactTypeSlack    ContactType = "Slack"
+	ContactTypeWebhook  ContactType = "Webhook"
+	ContactTypeTelegram ContactType = "Telegram"
+)
+
+//+kubebuilder:validation:XValidation:rule="has(self.value) != has(self.valueFrom)",message="exactly one of value or valueFrom is required"
+//+kubebuilder:validation:XValidation:rule="self.type != 'Email' || !has(self.value) || self.value.contains('@')",message="value must be an email address when type is Email"
+
+type ContactValues struct {
+	// ID binds this resource to an existing Pulsetic contact.
+	//+optional
+	ID int64 `json:"id,omitempty"`
+
+	// Name of the contact. Defaults to the resource name.
+	//+optional
+	Name string `json:"name,omitempty"`
+
+	// Type of notification channel.
+	Type ContactType `json:"type"`
+
+	// Value is the email address, Slack or webhook URL, or Telegram chat ID.
+	//+optional
+	Value string `json:"value,omitempty"`
+
+	// ValueFrom loads the value from a Secret, for webhook URLs or tokens that should not be stored in the spec.
+	//+optional
+	ValueFrom *ValueSource `json:"valueFrom,omitempty"`
+}
+
+// ToContact converts the values into a Pulsetic contact.
+func (c ContactValues) ToContact() pulsetic.Contact {
+	contact := pulsetic.Contact{
+		ID:    c.ID,
+		Name:  c.Name,
+		Value: c.Value,
+	}
+	switch c.Type {
+	case ContactTypeEmail:
+		contact.Type = pulsetic.ContactTypeEmail
+	case ContactTypeSlack:
+		contact.Type = pulsetic.ContactTypeSlack
+	case ContactTypeWebhook:
+		contact.Type = pulsetic.ContactTypeWebhook
+	case ContactTypeTelegram:
+		contact.Type = pulsetic.ContactTypeTelegram
+	}
+	return contact
+}
+
+// ContactReference references a Contact in the same namespace.
+type ContactReference struct {
+	// Name of the Contact.
+	Name string `json:"name"`
+}
+
+// ContactStatus defines the observed state of Contact.
+type ContactStatus struct {
+	// ObservedGeneration is the most recent generation observed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	ID int64 `json:"id,omitempty"`
+
+	// Conditions represent the latest available observations of the Contact's state.
+	//+listType=map
+	//+listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+//+kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.contact.type"
+//+kubebuilder:printcolumn:name="ID",type="integer",JSONPath=".status.id",priority=1
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// Contact is the Schema for the contacts API.
+type Contact struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ContactSpec   `json:"spec,omitempty"`
+	Status ContactStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ContactList contains a list of Contact.
+type ContactList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Contact `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Contact{}, &ContactList{})
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//+kubebuilder:validation:XValidation:rule="!has(oldSelf.resolved) || !oldSelf.resolved || self.resolved",message="a resolved incident cannot be reopened"
+//+kubebuilder:validation:XValidation:rule="!has(oldSelf.updates) || (has(self.updates) && size(self.updates) >= size(oldSelf.updates))",message="updates can only be appended"
+
+// IncidentSpec defines the desired state of Incident.
+type IncidentSpec struct {
+	// Account references this object's Account. If not specified, the default will be used.
+	Account corev1.LocalObjectReference `json:"account,omitempty"`
+
+	// StatusPage references the StatusPage in this namespace that the incident is posted to.
+	//+optional
+	StatusPage *corev1.LocalObjectReference `json:"statusPage,omitempty"`
+
+	// Title summarizes the incident.
+	//+kubebuilder:validation:MinLength=1
+	Title string `json:"title"`
+
+	// Severity of the incident.
+	//+kubebuilder:default:=Minor
+	Severity IncidentSeverity `json:"severity,omitempty"`
+
+	// Monitors lists the affected Monitors in this namespace.
+	//+optional
+	Monitors []corev1.LocalObjectReference `json:"monitors,omitempty"`
+
+	// Updates is the incident timeline. Updates are posted in order and can only be appended.
+	//+optional
+	Updates []IncidentUpdate `json:"updates,omitempty"`
+
+	// Resolved marks the incident as resolved. Deleting the resource also resolves the incident.
+	//+optional
+	Resolved bool `json:"resolved,omitempty"`
+
+	// ResolvedMessage is posted to the timeline when the incident is resolved.
+	//+kubebuilder:default:="This incident has been resolved."
+	ResolvedMessage string `json:"resolvedMessage,omitempty"`
+}
+
+//+kubebuilder:validation:Enum=Minor;Major;Critical
+
+type IncidentSeverity string
+
+const (
+	IncidentSeverityMinor    IncidentSeverity = "Minor"
+	IncidentSeverityMajor    IncidentSeverity = "Major"
+	IncidentSeverityCritical IncidentSeverity = "Critical"
+)
+
+// ToPulsetic converts the severity to its Pulsetic value.
+func (s IncidentSeverity) ToPulsetic() pulsetic.IncidentSeverity {
+	switch s {
+	case IncidentSeverityMajor:
+		return pulsetic.IncidentSeverityMajor
+	case IncidentSeverityCritical:
+		return pulsetic.IncidentSeverityCritical
+	default:
+		return pulsetic.IncidentSeverityMinor
+	}
+}
+
+//+kubebuilder:validation:Enum=Investigating;Identified;Monitoring
+
+type IncidentState string
+
+const (
+	IncidentStateInvestigating IncidentState = "Investigating"
+	IncidentStateIdentified    IncidentState = "Identified"
+	IncidentStateMonitoring    IncidentState = "Monitoring"
+)
+
+// ToPulsetic converts the state to its Pulsetic value.
+func (s IncidentState) ToPulsetic() pulsetic.IncidentStatus {
+	switch s {
+	case IncidentStateIdentified:
+		return pulsetic.IncidentStatusIdentified
+	case IncidentStateMonitoring:
+		return pulsetic.IncidentStatusMonitoring
+	default:
+		return pulsetic.IncidentStatusInvestigating
+	}
+}
+
+type IncidentUpdate struct {
+	// State of the incident at the time of this update.
+	//+kubebuilder:default:=Investigating
+	State IncidentState `json:"state,omitempty"`
+
+	// Message describes the update.
+	//+kubebuilder:validation:MinLength=1
+	Message string `json:"message"`
+}
+
+// IncidentStatus defines the observed state of Incident.
+type IncidentStatus struct {
+	// ObservedGeneration is the most recent generation observed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	ID int64 `json:"id,omitempty"`
+
+	// PublishedUpdates is the number of timeline updates posted to Pulsetic.
+	PublishedUpdates int32 `json:"publishedUpdates,omitempty"`
+
+	// Resolved is true once the incident has been resolved in Pulsetic.
+	Resolved bool `json:"resolved,omitempty"`
+
+	// ResolvedAt is the time the incident was resolved.
+	ResolvedAt *metav1.Time `json:"resolvedAt,omitempty"`
+
+	// Conditions represent the latest available observations of the Incident's state.
+	//+listType=map
+	//+listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+//+kubebuilder:printcolumn:name="Title",type="string",JSONPath=".spec.title"
+//+kubebuilder:printcolumn:name="Severity",type="string",JSONPath=".spec.severity"
+//+kubebuilder:printcolumn:name="Resolved",type="boolean",JSONPath=".status.resolved"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// Incident is the Schema for the incidents API.
+type Incident struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IncidentSpec   `json:"spec,omitempty"`
+	Status IncidentStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// IncidentList contains a list of Incident.
+type IncidentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Incident `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Incident{}, &IncidentList{})
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//+kubebuilder:validation:XValidation:rule="has(self.schedule) != has(self.start)",message="exactly one of schedule or start is required"
+//+kubebuilder:validation:XValidation:rule="!has(self.schedule) || has(self.duration)",message="duration is required when schedule is set"
+//+kubebuilder:validation:XValidation:rule="!has(self.start) || has(self.end) != has(self.duration)",message="exactly one of end or duration is required when start is set"
+//+kubebuilder:validation:XValidation:rule="!has(self.start) || !has(self.end) || self.end > self.start",message="end must be after start"
+
+// MaintenanceWindowSpec defines the desired state of MaintenanceWindow.
+type MaintenanceWindowSpec struct {
+	// Schedule is a cron expression for recurring windows, e.g. "0 2 * * sun".
+	//+optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// TimeZone is the IANA time zone used to evaluate the schedule. Defaults to UTC.
+	//+optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Start is the beginning of a one-off window.
+	//+optional
+	Start *metav1.Time `json:"start,omitempty"`
+
+	// End is the end of a one-off window.
+	//+optional
+	End *metav1.Time `json:"end,omitempty"`
+
+	// Duration is the length of each window.
+	//+optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Selector selects the Monitors in this namespace to pause during the window.
+	Selector metav1.LabelSelector `json:"selector"`
+}
+
+// MaintenanceWindowStatus defines the observed state of MaintenanceWindow.
+type MaintenanceWindowStatus struct {
+	// ObservedGeneration is the most recent generation observed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Active is true while the window is open.
+	Active bool `json:"active"`
+
+	// CurrentStart is the start of the open window.
+	CurrentStart *metav1.Time `json:"currentStart,omitempty"`
+
+	// CurrentEnd is the end of the open window.
+	CurrentEnd *metav1.Time `json:"currentEnd,omitempty"`
+
+	// NextStart is the start of the next window.
+	NextStart *metav1.Time `json:"nextStart,omitempty"`
+
+	// Monitors is the number of Monitors paused by this window.
+	Monitors int32 `json:"monitors,omitempty"`
+
+	// Conditions represent the latest available observations of the MaintenanceWindow's state.
+	//+listType=map
+	//+listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:shortName=mw
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+//+kubebuilder:printcolumn:name="Active",type="boolean",JSONPath=".status.active"
+//+kubebuilder:printcolumn:name="Monitors",type="integer",JSONPath=".status.monitors"
+//+kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=".spec.schedule"
+//+kubebuilder:printcolumn:name="Next Start",type="date",JSONPath=".status.nextStart"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// MaintenanceWindow is the Schema for the maintenancewindows API.
+type MaintenanceWindow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MaintenanceWindowSpec   `json:"spec,omitempty"`
+	Status MaintenanceWindowStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// MaintenanceWindowList contains a list of MaintenanceWindow.
+type MaintenanceWindowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MaintenanceWindow `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MaintenanceWindow{}, &MaintenanceWindowList{})
+}
@@ -17,11 +17,16 @@ limitations under the License.
 package v1
 
 import (
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
 	"github.com/clevyr/pulsetic-operator/internal/pulsetic/pulsetictypes"
 	"github.com/clevyr/pulsetic-operator/internal/util"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 // MonitorSpec defines the desired state of Monitor.
@@ -30,14 +35,45 @@ type MonitorSpec struct {
 	//+kubebuilder:default:="24h"
 	Interval *metav1.Duration `json:"interval,omitempty"`
 
-	// Prune enables garbage collection.
+	// Prune deletes the Pulsetic monitor when this resource is deleted, and allows garbage collection to remove it.
+	// When false, the monitor is released and left in Pulsetic.
 	//+kubebuilder:default:=true
 	Prune bool `json:"prune,omitempty"`
 
-	// Suspend pauses reconciliation of this resource.
+	// Suspend pauses checks for the Pulsetic monitor. Spec changes are still applied.
 	//+optional
 	Suspend bool `json:"suspend,omitempty"`
 
+	// Replicas pauses checks for the Pulsetic monitor when set to 0.
+	// It backs the scale subresource, so `kubectl scale --replicas=0` can be used to silence checks.
+	//+kubebuilder:default:=1
+	//+kubebuilder:validation:Minimum=0
+	//+kubebuilder:validation:Maximum=1
+	//+optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Adopt controls whether an existing Pulsetic monitor with the same URL is adopted.
+	// IfExists adopts a matching monitor or creates a new one, Never always creates a new monitor,
+	// and Always requires an existing monitor and never creates one.
+	//+kubebuilder:default:=IfExists
+	Adopt AdoptPolicy `json:"adopt,omitempty"`
+
+	// DriftPolicy controls what happens when the Pulsetic monitor is changed outside of this resource.
+	//+kubebuilder:default:=Correct
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// UnsupportedValuePolicy controls what happens when the interval or offline notification delay
+	// is not one of the values accepted by Pulsetic.
+	// Snap uses the nearest accepted value and records a Warning event, and Reject refuses to apply the monitor.
+	//+kubebuilder:default:=Snap
+	UnsupportedValuePolicy UnsupportedValuePolicy `json:"unsupportedValuePolicy,omitempty"`
+
+	// SSLExpiryWarningDays sets how many days before the SSL certificate expires to start warning.
+	// Set to 0 to disable the warning.
+	//+kubebuilder:default:=14
+	//+kubebuilder:validation:Minimum=0
+	SSLExpiryWarningDays int32 `json:"sslExpiryWarningDays,omitempty"`
+
 	// Account references this object's Account. If not specified, the default will be used.
 	Account corev1.LocalObjectReference `json:"account,omitempty"`
 
@@ -45,20 +81,200 @@ type MonitorSpec struct {
 	Monitor MonitorValues `json:"monitor"`
 }
 
+//+kubebuilder:validation:Enum=IfExists;Never;Always
+
+type AdoptPolicy string
+
+const (
+	AdoptPolicyIfExists AdoptPolicy = "IfExists"
+	AdoptPolicyNever    AdoptPolicy = "Never"
+	AdoptPolicyAlways   AdoptPolicy = "Always"
+)
+
+//+kubebuilder:validation:Enum=Correct;Report;Ignore
+
+type DriftPolicy string
+
+const (
+	// DriftPolicyCorrect overwrites changes made outside of the operator.
+	DriftPolicyCorrect DriftPolicy = "Correct"
+	// DriftPolicyReport reports changes made outside of the operator without overwriting them.
+	DriftPolicyReport DriftPolicy = "Report"
+	// DriftPolicyIgnore skips drift detection. The monitor is only updated when the spec changes.
+	DriftPolicyIgnore DriftPolicy = "Ignore"
+)
+
+//+kubebuilder:validation:Enum=Snap;Reject
+
+type UnsupportedValuePolicy string
+
+const (
+	// UnsupportedValuePolicySnap uses the nearest value accepted by Pulsetic.
+	UnsupportedValuePolicySnap UnsupportedValuePolicy = "Snap"
+	// UnsupportedValuePolicyReject refuses to apply values that Pulsetic does not accept.
+	UnsupportedValuePolicyReject UnsupportedValuePolicy = "Reject"
+)
+
 // MonitorStatus defines the observed state of Monitor.
 type MonitorStatus struct {
-	Ready   bool  `json:"ready"`
+	// Ready mirrors the status of the Ready condition.
+	//
+	// Deprecated: use the Ready condition instead. This field will be removed in a future release.
+	//+optional
+	Ready bool `json:"ready"`
+
+	// ObservedGeneration is the most recent generation observed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
 	ID      int64 `json:"id,omitempty"`
 	Running bool  `json:"running,omitempty"`
+
+	// Replicas is 1 while the Pulsetic monitor is running and 0 while it is paused.
+	Replicas int32 `json:"replicas"`
+
+	// Contacts lists the IDs of the Pulsetic contacts last assigned to the monitor.
+	//+optional
+	Contacts []int64 `json:"contacts,omitempty"`
+
+	// MaintenanceWindows lists the active MaintenanceWindows that pause this monitor.
+	//+listType=set
+	MaintenanceWindows []string `json:"maintenanceWindows,omitempty"`
+
+	// LastAppliedHash is a digest of the settings last sent to Pulsetic.
+	LastAppliedHash string `json:"lastAppliedHash,omitempty"`
+
+	// State is the result of the latest check, as reported by Pulsetic.
+	State string `json:"state,omitempty"`
+
+	// Uptime is the uptime percentage reported by Pulsetic.
+	Uptime string `json:"uptime,omitempty"`
+
+	// ResponseTime is the average response time.
+	ResponseTime *metav1.Duration `json:"responseTime,omitempty"`
+
+	// LastCheckedAt is the time of the latest check.
+	LastCheckedAt *metav1.Time `json:"lastCheckedAt,omitempty"`
+
+	// StatusChangedAt is the time the state last changed.
+	StatusChangedAt *metav1.Time `json:"statusChangedAt,omitempty"`
+
+	// Nodes reports the latest check result from each region.
+	//+optional
+	Nodes []NodeStatus `json:"nodes,omitempty"`
 	// SourceRef references the object that created this Monitor.
 	SourceRef *corev1.TypedLocalObjectReference `json:"sourceRef,omitempty"`
+
+	// SSLCertificate reports the certificate presented by the monitored site.
+	SSLCertificate *SSLCertificateStatus `json:"sslCertificate,omitempty"`
+
+	// Lighthouse reports the latest Lighthouse audit scores.
+	Lighthouse *LighthouseStatus `json:"lighthouse,omitempty"`
+
+	// Effective reports the Account and the settings, after defaults are applied, that were last sent to Pulsetic.
+	Effective *EffectiveMonitorValues `json:"effective,omitempty"`
+
+	// Conditions represent the latest available observations of the Monitor's state.
+	//+listType=map
+	//+listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+type NodeStatus struct {
+	// Title is the node's display name.
+	Title string `json:"title"`
+
+	// Location is the region the node runs checks from.
+	Location string `json:"location,omitempty"`
+
+	// Active is true if the monitor runs checks from this node.
+	Active bool `json:"active,omitempty"`
+
+	// State is the result of the node's latest check.
+	State string `json:"state,omitempty"`
+
+	// Uptime is the node's uptime percentage.
+	Uptime int32 `json:"uptime,omitempty"`
+
+	// ResponseTime is the node's average response time.
+	ResponseTime *metav1.Duration `json:"responseTime,omitempty"`
+}
+
+type LighthouseConfig struct {
+	// Enabled turns on Lighthouse audits.
+	Enabled bool `json:"enabled"`
+
+	// MinScores sets the minimum acceptable score for each category, applied to both mobile and desktop audits.
+	// A score below its minimum sets the Degraded condition.
+	//+optional
+	MinScores *LighthouseScores `json:"minScores,omitempty"`
+}
+
+// LighthouseScores holds a score from 0 to 100 for each Lighthouse category.
+type LighthouseScores struct {
+	//+kubebuilder:validation:Minimum=0
+	//+kubebuilder:validation:Maximum=100
+	//+optional
+	Performance *int32 `json:"performance,omitempty"`
+
+	//+kubebuilder:validation:Minimum=0
+	//+kubebuilder:validation:Maximum=100
+	//+optional
+	Accessibility *int32 `json:"accessibility,omitempty"`
+
+	//+kubebuilder:validation:Minimum=0
+	//+kubebuilder:validation:Maximum=100
+	//+optional
+	BestPractices *int32 `json:"bestPractices,omitempty"`
+
+	//+kubebuilder:validation:Minimum=0
+	//+kubebuilder:validation:Maximum=100
+	//+optional
+	SEO *int32 `json:"seo,omitempty"`
+
+	//+kubebuilder:validation:Minimum=0
+	//+kubebuilder:validation:Maximum=100
+	//+optional
+	PWA *int32 `json:"pwa,omitempty"`
+}
+
+type LighthouseStatus struct {
+	// Mobile holds the scores of the mobile audit.
+	Mobile LighthouseScores `json:"mobile,omitzero"`
+
+	// Desktop holds the scores of the desktop audit.
+	Desktop LighthouseScores `json:"desktop,omitzero"`
+}
+
+type EffectiveMonitorValues struct {
+	// Account is the name of the Account the monitor belongs to.
+	Account string `json:"account,omitempty"`
+
+	MonitorDefaults `json:",inline"`
+}
+
+type SSLCertificateStatus struct {
+	// Domain is the domain the certificate was issued for.
+	Domain string `json:"domain,omitempty"`
+
+	// Issuer is the certificate's issuer.
+	Issuer string `json:"issuer,omitempty"`
+
+	// Valid is true if Pulsetic considers the certificate valid.
+	Valid bool `json:"valid"`
+
+	// ExpiresAt is the time the certificate expires.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
-//+kubebuilder:subresource:scale:specpath=.spec.monitor.status,statuspath=.status.status
-//+kubebuilder:printcolumn:name="Ready",type="boolean",JSONPath=".status.ready"
+//+kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
 //+kubebuilder:printcolumn:name="Running",type="string",JSONPath=".status.running"
+//+kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+//+kubebuilder:printcolumn:name="Uptime",type="string",JSONPath=".status.uptime"
+//+kubebuilder:printcolumn:name="Response Time",type="string",JSONPath=".status.responseTime"
+//+kubebuilder:printcolumn:name="Last Checked",type="date",JSONPath=".status.lastCheckedAt",priority=1
 //+kubebuilder:printcolumn:name="Friendly Name",type="string",JSONPath=".spec.monitor.name"
 //+kubebuilder:printcolumn:name="URL",type="string",JSONPath=".spec.monitor.url"
 //+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
@@ -73,20 +289,155 @@ type Monitor struct {
 }
 
 //+kubebuilder:object:generate=true
+//+kubebuilder:validation:XValidation:rule="!has(self.type) || self.type != 'TCP' || (has(self.ports) && size(self.ports) != 0)",message="ports is required when type is TCP"
+//+kubebuilder:validation:XValidation:rule="!has(self.type) || self.type == 'HTTP' || self.url.matches('^[a-zA-Z0-9.:-]+$')",message="url must be a hostname or IP when type is TCP or ICMP"
 
 type MonitorValues struct {
+	// ID binds this resource to an existing Pulsetic monitor.
+	// If set, the monitor is never looked up by URL or created.
+	//+optional
+	ID int64 `json:"id,omitempty"`
+
 	// Name sets the name shown in Pulsetic.
 	Name string `json:"name"`
 
 	// URL is the URL or IP to monitor, including the scheme.
+	// TCP and ICMP monitors take a bare hostname or IP.
 	URL string `json:"url"`
 
 	// Type chooses the monitor type.
 	Type *pulsetictypes.RequestType `json:"type,omitempty"`
 
+	// Ports lists the ports to check when type is TCP.
+	//+optional
+	//+listType=set
+	//+kubebuilder:validation:items:Minimum=1
+	//+kubebuilder:validation:items:Maximum=65535
+	Ports []int32 `json:"ports,omitempty"`
+
+	// Regions limits the check nodes to those whose title or location matches one of these values.
+	// Available nodes are validated against Pulsetic. If empty, Pulsetic's default nodes are used.
+	//+optional
+	//+listType=set
+	Regions []string `json:"regions,omitempty"`
+
+	// Request configures the HTTP request sent by the check.
+	//+optional
+	Request *MonitorRequest `json:"request,omitempty"`
+
+	// Response configures the HTTP response expected by the check.
+	//+optional
+	Response *MonitorResponse `json:"response,omitempty"`
+
+	// Notifications lists the Contacts in this namespace that are alerted when the monitor changes state.
+	//+optional
+	//+listType=map
+	//+listMapKey=name
+	Notifications []ContactReference `json:"notifications,omitempty"`
+
+	// Lighthouse configures Lighthouse audits of the monitored page.
+	//+optional
+	Lighthouse *LighthouseConfig `json:"lighthouse,omitempty"`
+
 	MonitorDefaults `json:",inline"`
 }
 
+type MonitorRequest struct {
+	// Headers are sent with each request.
+	//+optional
+	//+listType=map
+	//+listMapKey=name
+	Headers []Header `json:"headers,omitempty"`
+
+	// Body is sent with each request.
+	//+optional
+	Body *RequestBody `json:"body,omitempty"`
+}
+
+//+kubebuilder:validation:XValidation:rule="[has(self.raw), has(self.rawFrom), has(self.json), has(self.jsonFrom), has(self.form)].filter(x, x).size() <= 1",message="only one of raw, rawFrom, json, jsonFrom, or form may be set"
+
+type RequestBody struct {
+	// Raw sends the body as plain text.
+	//+optional
+	Raw string `json:"raw,omitempty"`
+
+	// RawFrom sources the plain text body from another resource.
+	//+optional
+	RawFrom *ValueSource `json:"rawFrom,omitempty"`
+
+	// JSON sends the body as a JSON document.
+	//+optional
+	JSON string `json:"json,omitempty"`
+
+	// JSONFrom sources the JSON body from another resource.
+	//+optional
+	JSONFrom *ValueSource `json:"jsonFrom,omitempty"`
+
+	// Form sends the body as form parameters.
+	//+optional
+	//+listType=atomic
+	Form []FormParam `json:"form,omitempty"`
+}
+
+//+kubebuilder:validation:XValidation:rule="!(has(self.bodyContains) && has(self.bodyNotContains))",message="only one of bodyContains or bodyNotContains may be set"
+
+type MonitorResponse struct {
+	// ExpectedStatusCode is the HTTP status code required for the check to be considered up.
+	//+optional
+	//+kubebuilder:validation:Minimum=100
+	//+kubebuilder:validation:Maximum=599
+	ExpectedStatusCode *int32 `json:"expectedStatusCode,omitempty"`
+
+	// BodyContains is a keyword that must be present in the response body.
+	//+optional
+	BodyContains string `json:"bodyContains,omitempty"`
+
+	// BodyNotContains is a keyword that must not be present in the response body.
+	//+optional
+	BodyNotContains string `json:"bodyNotContains,omitempty"`
+
+	// Headers must be present in the response.
+	//+optional
+	//+listType=map
+	//+listMapKey=name
+	Headers []Header `json:"headers,omitempty"`
+}
+
+//+kubebuilder:validation:XValidation:rule="!(has(self.value) && has(self.valueFrom))",message="only one of value or valueFrom may be set"
+
+type Header struct {
+	// Name of the header.
+	Name string `json:"name"`
+
+	// Value of the header.
+	//+optional
+	Value string `json:"value,omitempty"`
+
+	// ValueFrom sources the value from another resource.
+	//+optional
+	ValueFrom *ValueSource `json:"valueFrom,omitempty"`
+}
+
+//+kubebuilder:validation:XValidation:rule="!(has(self.value) && has(self.valueFrom))",message="only one of value or valueFrom may be set"
+
+type FormParam struct {
+	// Name of the parameter.
+	Name string `json:"name"`
+
+	// Value of the parameter.
+	//+optional
+	Value string `json:"value,omitempty"`
+
+	// ValueFrom sources the value from another resource.
+	//+optional
+	ValueFrom *ValueSource `json:"valueFrom,omitempty"`
+}
+
+type ValueSource struct {
+	// SecretKeyRef selects a key of a Secret in the Monitor's namespace.
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef"`
+}
+
 type MonitorDefaults struct {
 	// Interval is the monitoring interval.
 	//+optional
@@ -105,12 +456,66 @@ type MonitorDefaults struct {
 	// OfflineNotificationDelay waits to notify until the site has been down for a time.
 	//+optional
 	OfflineNotificationDelay *metav1.Duration `json:"offlineNotificationDelay,omitempty"`
+
+	// SSLCheck enables SSL certificate monitoring.
+	//+optional
+	SSLCheck *bool `json:"sslCheck,omitempty"`
 }
 
-func (m MonitorValues) ToMonitor(defaults *MonitorDefaults) pulsetic.Monitor {
+//+kubebuilder:object:generate=false
+
+// SnappedValue describes a setting that was replaced with the nearest value accepted by Pulsetic.
+type SnappedValue struct {
+	// Field is the JSON name of the setting.
+	Field string
+	From  time.Duration
+	To    time.Duration
+}
+
+func (s SnappedValue) String() string {
+	return s.Field + " " + s.From.String() + " is not supported by Pulsetic, nearest is " + s.To.String()
+}
+
+// Snap replaces settings that Pulsetic does not accept with the nearest accepted value.
+// It returns the settings that were changed.
+func (d *MonitorDefaults) Snap() []SnappedValue {
+	var snapped []SnappedValue
+	for _, f := range []struct {
+		name    string
+		value   **metav1.Duration
+		allowed []time.Duration
+	}{
+		{"interval", &d.Interval, pulsetic.CheckFrequencies},
+		{"offlineNotificationDelay", &d.OfflineNotificationDelay, pulsetic.OfflineNotificationDelays},
+	} {
+		if *f.value == nil {
+			continue
+		}
+		from := (*f.value).Duration
+		if to := pulsetic.Nearest(f.allowed, from); to != from {
+			snapped = append(snapped, SnappedValue{Field: f.name, From: from, To: to})
+			*f.value = &metav1.Duration{Duration: to}
+		}
+	}
+	return snapped
+}
+
+// EffectiveDefaults returns the monitor's settings with unset fields filled from defaults.
+func (m MonitorValues) EffectiveDefaults(defaults *MonitorDefaults) MonitorDefaults {
 	if defaults == nil {
 		defaults = &MonitorDefaults{}
 	}
+	return MonitorDefaults{
+		Interval:                 util.FirstValue(m.Interval, defaults.Interval),
+		Method:                   util.FirstValue(m.Method, defaults.Method),
+		Timeout:                  util.FirstValue(m.Timeout, defaults.Timeout),
+		OfflineNotificationDelay: util.FirstValue(m.OfflineNotificationDelay, defaults.OfflineNotificationDelay),
+		SSLCheck:                 util.FirstValue(m.SSLCheck, defaults.SSLCheck),
+	}
+}
+
+func (m MonitorValues) ToMonitor(defaults *MonitorDefaults) pulsetic.Monitor {
+	effective := m.EffectiveDefaults(defaults)
 	v := pulsetic.Monitor{
 		Name: m.Name,
 		URL:  m.URL,
@@ -118,21 +523,78 @@ func (m MonitorValues) ToMonitor(defaults *MonitorDefaults) pulsetic.Monitor {
 	if m.Type != nil {
 		v.RequestType = *m.Type
 	}
-	if interval := util.FirstValue(m.Interval, defaults.Interval); interval != nil {
+	if len(m.Ports) != 0 {
+		ports := make([]string, 0, len(m.Ports))
+		for _, port := range m.Ports {
+			ports = append(ports, strconv.Itoa(int(port)))
+		}
+		v.TCPPorts = strings.Join(ports, ",")
+	}
+	if interval := effective.Interval; interval != nil {
 		v.UptimeCheckFrequency = int(interval.Seconds() + 0.5)
 	}
-	if method := util.FirstValue(m.Method, defaults.Method); method != nil {
+	if method := effective.Method; method != nil {
 		v.RequestMethod = *method
 	}
-	if timeout := util.FirstValue(m.Timeout, defaults.Timeout); timeout != nil {
+	if timeout := effective.Timeout; timeout != nil {
 		v.RequestTimeout = timeout.Seconds()
 	}
-	if offlineDelay := util.FirstValue(m.OfflineNotificationDelay, defaults.OfflineNotificationDelay); offlineDelay != nil {
+	if offlineDelay := effective.OfflineNotificationDelay; offlineDelay != nil {
 		v.OfflineNotificationDelay = int(offlineDelay.Minutes() + 0.5)
 	}
+	if sslCheck := effective.SSLCheck; sslCheck != nil {
+		v.SSLCheck = ptr.To(pulsetic.IntBool(*sslCheck))
+	}
+	if m.Lighthouse != nil {
+		v.LighthouseAuditEnabled = ptr.To(pulsetic.IntBool(m.Lighthouse.Enabled))
+	}
+	if m.Request != nil {
+		for _, header := range m.Request.Headers {
+			v.RequestHeaders = append(v.RequestHeaders, pulsetic.Header{Name: header.Name, Value: header.Value})
+		}
+		if body := m.Request.Body; body != nil {
+			switch {
+			case body.Raw != "":
+				v.RequestBodyType = pulsetic.BodyTypeRaw
+				v.RequestBodyRaw = body.Raw
+			case body.JSON != "":
+				v.RequestBodyType = pulsetic.BodyTypeJSON
+				v.RequestBodyJSON = body.JSON
+			case len(body.Form) != 0:
+				v.RequestBodyType = pulsetic.BodyTypeFormParams
+				for _, param := range body.Form {
+					v.RequestBodyFormParams = append(v.RequestBodyFormParams,
+						pulsetic.FormParam{Name: param.Name, Value: param.Value},
+					)
+				}
+			}
+		}
+	}
+	if m.Response != nil {
+		if m.Response.ExpectedStatusCode != nil {
+			v.ResponseExpectedCode = strconv.Itoa(int(*m.Response.ExpectedStatusCode))
+		}
+		switch {
+		case m.Response.BodyContains != "":
+			v.ResponseBody = m.Response.BodyContains
+			v.IsNegative = ptr.To[pulsetic.IntBool](false)
+		case m.Response.BodyNotContains != "":
+			v.ResponseBody = m.Response.BodyNotContains
+			v.IsNegative = ptr.To[pulsetic.IntBool](true)
+		}
+		for _, header := range m.Response.Headers {
+			v.ResponseHeaders = append(v.ResponseHeaders, pulsetic.Header{Name: header.Name, Value: header.Value})
+		}
+	}
 	return v
 }
 
+// Paused returns true if checks for the Pulsetic monitor should be stopped,
+// either by the spec or by an active MaintenanceWindow.
+func (m *Monitor) Paused() bool {
+	return m.Spec.Suspend || (m.Spec.Replicas != nil && *m.Spec.Replicas == 0) || len(m.Status.MaintenanceWindows) != 0
+}
+
 //+kubebuilder:object:root=true
 
 // MonitorList contains a list of Monitor.
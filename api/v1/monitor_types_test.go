@@ -0,0 +1,195 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic/pulsetictypes"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestMonitorValues_ToMonitor(t *testing.T) {
+	tcp := pulsetictypes.RequestTypeTCP
+	expectedCode := int32(204)
+
+	tests := []struct {
+		name   string
+		values MonitorValues
+		want   pulsetic.Monitor
+	}{
+		{
+			"minimal",
+			MonitorValues{Name: "Example", URL: "https://example.com"},
+			pulsetic.Monitor{Name: "Example", URL: "https://example.com"},
+		},
+		{
+			"tcp ports",
+			MonitorValues{Name: "Example", URL: "example.com", Type: &tcp, Ports: []int32{80, 443}},
+			pulsetic.Monitor{Name: "Example", URL: "example.com", RequestType: tcp, TCPPorts: "80,443"},
+		},
+		{
+			"request",
+			MonitorValues{
+				Name: "Example",
+				URL:  "https://example.com",
+				Request: &MonitorRequest{
+					Headers: []Header{{Name: "Authorization", Value: "Bearer token"}},
+					Body:    &RequestBody{Form: []FormParam{{Name: "a", Value: "b"}}},
+				},
+			},
+			pulsetic.Monitor{
+				Name:                  "Example",
+				URL:                   "https://example.com",
+				RequestHeaders:        []pulsetic.Header{{Name: "Authorization", Value: "Bearer token"}},
+				RequestBodyType:       pulsetic.BodyTypeFormParams,
+				RequestBodyFormParams: []pulsetic.FormParam{{Name: "a", Value: "b"}},
+			},
+		},
+		{
+			"raw body",
+			MonitorValues{
+				Name:    "Example",
+				URL:     "https://example.com",
+				Request: &MonitorRequest{Body: &RequestBody{Raw: "ping"}},
+			},
+			pulsetic.Monitor{
+				Name:            "Example",
+				URL:             "https://example.com",
+				RequestBodyType: pulsetic.BodyTypeRaw,
+				RequestBodyRaw:  "ping",
+			},
+		},
+		{
+			"json body",
+			MonitorValues{
+				Name:    "Example",
+				URL:     "https://example.com",
+				Request: &MonitorRequest{Body: &RequestBody{JSON: `{"a":"b"}`}},
+			},
+			pulsetic.Monitor{
+				Name:            "Example",
+				URL:             "https://example.com",
+				RequestBodyType: pulsetic.BodyTypeJSON,
+				RequestBodyJSON: `{"a":"b"}`,
+			},
+		},
+		{
+			"response",
+			MonitorValues{
+				Name: "Example",
+				URL:  "https://example.com",
+				Response: &MonitorResponse{
+					ExpectedStatusCode: &expectedCode,
+					BodyNotContains:    "maintenance",
+				},
+			},
+			pulsetic.Monitor{
+				Name:                 "Example",
+				URL:                  "https://example.com",
+				ResponseExpectedCode: "204",
+				ResponseBody:         "maintenance",
+				IsNegative:           ptr.To[pulsetic.IntBool](true),
+			},
+		},
+		{
+			"response contains",
+			MonitorValues{
+				Name:     "Example",
+				URL:      "https://example.com",
+				Response: &MonitorResponse{BodyContains: "ok"},
+			},
+			pulsetic.Monitor{
+				Name:         "Example",
+				URL:          "https://example.com",
+				ResponseBody: "ok",
+				IsNegative:   ptr.To[pulsetic.IntBool](false),
+			},
+		},
+		{
+			"lighthouse disabled",
+			MonitorValues{Name: "Example", URL: "https://example.com", Lighthouse: &LighthouseConfig{}},
+			pulsetic.Monitor{
+				Name:                   "Example",
+				URL:                    "https://example.com",
+				LighthouseAuditEnabled: ptr.To[pulsetic.IntBool](false),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.values.ToMonitor(nil))
+		})
+	}
+}
+
+func TestMonitorValues_EffectiveDefaults(t *testing.T) {
+	sslCheck := true
+	values := MonitorValues{MonitorDefaults: MonitorDefaults{
+		Interval: &metav1.Duration{Duration: time.Minute},
+	}}
+	defaults := &MonitorDefaults{
+		Interval: &metav1.Duration{Duration: 5 * time.Minute},
+		SSLCheck: &sslCheck,
+	}
+
+	got := values.EffectiveDefaults(defaults)
+	assert.Equal(t, time.Minute, got.Interval.Duration)
+	assert.Equal(t, &sslCheck, got.SSLCheck)
+	assert.Nil(t, got.Timeout)
+
+	assert.Equal(t, values.MonitorDefaults, values.EffectiveDefaults(nil))
+}
+
+func TestMonitorDefaults_Snap(t *testing.T) {
+	interval := &metav1.Duration{Duration: 45 * time.Second}
+	defaults := MonitorDefaults{
+		Interval:                 interval,
+		OfflineNotificationDelay: &metav1.Duration{Duration: 5 * time.Minute},
+	}
+
+	snapped := defaults.Snap()
+	assert.Equal(t, []SnappedValue{{Field: "interval", From: 45 * time.Second, To: time.Minute}}, snapped)
+	assert.Equal(t, time.Minute, defaults.Interval.Duration)
+	assert.Equal(t, 5*time.Minute, defaults.OfflineNotificationDelay.Duration)
+	assert.Equal(t, 45*time.Second, interval.Duration, "the original value should not be modified")
+
+	assert.Empty(t, defaults.Snap())
+}
+
+func TestMonitor_Paused(t *testing.T) {
+	tests := []struct {
+		name    string
+		monitor Monitor
+		want    bool
+	}{
+		{"running", Monitor{}, false},
+		{"one replica", Monitor{Spec: MonitorSpec{Replicas: ptr.To[int32](1)}}, false},
+		{"suspended", Monitor{Spec: MonitorSpec{Suspend: true}}, true},
+		{"zero replicas", Monitor{Spec: MonitorSpec{Replicas: ptr.To[int32](0)}}, true},
+		{"maintenance window", Monitor{Status: MonitorStatus{MaintenanceWindows: []string{"deploy"}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.monitor.Paused())
+		})
+	}
+}
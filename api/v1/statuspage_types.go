@@ -0,0 +1,130 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StatusPageSpec defines the desired state of StatusPage.
+type StatusPageSpec struct {
+	// Interval defines the reconcile interval.
+	//+kubebuilder:default:="24h"
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Prune enables garbage collection.
+	//+kubebuilder:default:=true
+	Prune bool `json:"prune,omitempty"`
+
+	// Account references this object's Account. If not specified, the default will be used.
+	Account corev1.LocalObjectReference `json:"account,omitempty"`
+
+	// Page configures the Pulsetic status page.
+	Page StatusPageValues `json:"page"`
+}
+
+type StatusPageValues struct {
+	// ID binds this resource to an existing Pulsetic status page.
+	//+optional
+	ID int64 `json:"id,omitempty"`
+
+	// Title is displayed at the top of the status page.
+	//+kubebuilder:validation:MinLength=1
+	Title string `json:"title"`
+
+	// CustomDomain serves the status page from a custom domain.
+	//+optional
+	CustomDomain string `json:"customDomain,omitempty"`
+
+	// LogoURL is the URL of the logo displayed on the status page.
+	//+optional
+	LogoURL string `json:"logoURL,omitempty"`
+
+	// Sections group the Monitors displayed on the status page.
+	//+listType=map
+	//+listMapKey=name
+	//+optional
+	Sections []StatusPageSection `json:"sections,omitempty"`
+}
+
+//+kubebuilder:validation:XValidation:rule="has(self.monitors) || has(self.selector)",message="one of monitors or selector is required"
+
+type StatusPageSection struct {
+	// Name of the section.
+	Name string `json:"name"`
+
+	// Monitors lists Monitors in this namespace by name.
+	//+optional
+	Monitors []corev1.LocalObjectReference `json:"monitors,omitempty"`
+
+	// Selector selects Monitors in this namespace by label.
+	// Selected Monitors are listed after the named ones, sorted by name.
+	//+optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// StatusPageStatus defines the observed state of StatusPage.
+type StatusPageStatus struct {
+	// ObservedGeneration is the most recent generation observed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	ID  int64  `json:"id,omitempty"`
+	URL string `json:"url,omitempty"`
+
+	// Monitors is the number of Monitors published on the status page.
+	Monitors int32 `json:"monitors,omitempty"`
+
+	// PendingMonitors lists referenced Monitors that do not have a Pulsetic monitor yet.
+	//+optional
+	PendingMonitors []string `json:"pendingMonitors,omitempty"`
+
+	// Conditions represent the latest available observations of the StatusPage's state.
+	//+listType=map
+	//+listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+//+kubebuilder:printcolumn:name="Title",type="string",JSONPath=".spec.page.title"
+//+kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.url"
+//+kubebuilder:printcolumn:name="Monitors",type="integer",JSONPath=".status.monitors"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// StatusPage is the Schema for the statuspages API.
+type StatusPage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StatusPageSpec   `json:"spec,omitempty"`
+	Status StatusPageStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// StatusPageList contains a list of StatusPage.
+type StatusPageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StatusPage `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&StatusPage{}, &StatusPageList{})
+}
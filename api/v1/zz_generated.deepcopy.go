@@ -33,7 +33,7 @@ func (in *Account) DeepCopyInto(out *Account) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Account.
@@ -54,6 +54,90 @@ func (in *Account) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountBinding) DeepCopyInto(out *AccountBinding) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountBinding.
+func (in *AccountBinding) DeepCopy() *AccountBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountBinding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountBindingList) DeepCopyInto(out *AccountBindingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccountBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountBindingList.
+func (in *AccountBindingList) DeepCopy() *AccountBindingList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountBindingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountBindingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountBindingSpec) DeepCopyInto(out *AccountBindingSpec) {
+	*out = *in
+	out.AccountRef = in.AccountRef
+	if in.APIKeySecretRef != nil {
+		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MonitorDefaults != nil {
+		in, out := &in.MonitorDefaults, &out.MonitorDefaults
+		*out = new(MonitorDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountBindingSpec.
+func (in *AccountBindingSpec) DeepCopy() *AccountBindingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountBindingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccountList) DeepCopyInto(out *AccountList) {
 	*out = *in
@@ -95,6 +179,16 @@ func (in *AccountSpec) DeepCopyInto(out *AccountSpec) {
 		*out = new(MonitorDefaults)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GarbageCollection != nil {
+		in, out := &in.GarbageCollection, &out.GarbageCollection
+		*out = new(GarbageCollection)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountSpec.
@@ -110,6 +204,17 @@ func (in *AccountSpec) DeepCopy() *AccountSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccountStatus) DeepCopyInto(out *AccountStatus) {
 	*out = *in
+	if in.LastGarbageCollection != nil {
+		in, out := &in.LastGarbageCollection, &out.LastGarbageCollection
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountStatus.
@@ -123,7 +228,7 @@ func (in *AccountStatus) DeepCopy() *AccountStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Monitor) DeepCopyInto(out *Monitor) {
+func (in *Contact) DeepCopyInto(out *Contact) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -131,18 +236,18 @@ func (in *Monitor) DeepCopyInto(out *Monitor) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitor.
-func (in *Monitor) DeepCopy() *Monitor {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Contact.
+func (in *Contact) DeepCopy() *Contact {
 	if in == nil {
 		return nil
 	}
-	out := new(Monitor)
+	out := new(Contact)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Monitor) DeepCopyObject() runtime.Object {
+func (in *Contact) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -150,131 +255,1067 @@ func (in *Monitor) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MonitorDefaults) DeepCopyInto(out *MonitorDefaults) {
+func (in *ContactList) DeepCopyInto(out *ContactList) {
 	*out = *in
-	if in.Interval != nil {
-		in, out := &in.Interval, &out.Interval
-		*out = new(metav1.Duration)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Contact, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Method != nil {
-		in, out := &in.Method, &out.Method
-		*out = new(pulsetictypes.RequestMethod)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContactList.
+func (in *ContactList) DeepCopy() *ContactList {
+	if in == nil {
+		return nil
 	}
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
-		*out = new(metav1.Duration)
-		**out = **in
+	out := new(ContactList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ContactList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.OfflineNotificationDelay != nil {
-		in, out := &in.OfflineNotificationDelay, &out.OfflineNotificationDelay
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContactReference) DeepCopyInto(out *ContactReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContactReference.
+func (in *ContactReference) DeepCopy() *ContactReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ContactReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContactSpec) DeepCopyInto(out *ContactSpec) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	out.Account = in.Account
+	in.Contact.DeepCopyInto(&out.Contact)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorDefaults.
-func (in *MonitorDefaults) DeepCopy() *MonitorDefaults {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContactSpec.
+func (in *ContactSpec) DeepCopy() *ContactSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MonitorDefaults)
+	out := new(ContactSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MonitorList) DeepCopyInto(out *MonitorList) {
+func (in *ContactStatus) DeepCopyInto(out *ContactStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Monitor, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorList.
-func (in *MonitorList) DeepCopy() *MonitorList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContactStatus.
+func (in *ContactStatus) DeepCopy() *ContactStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MonitorList)
+	out := new(ContactStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MonitorList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContactValues) DeepCopyInto(out *ContactValues) {
+	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(ValueSource)
+		(*in).DeepCopyInto(*out)
 	}
-	return nil
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContactValues.
+func (in *ContactValues) DeepCopy() *ContactValues {
+	if in == nil {
+		return nil
+	}
+	out := new(ContactValues)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MonitorSpec) DeepCopyInto(out *MonitorSpec) {
+func (in *EffectiveMonitorValues) DeepCopyInto(out *EffectiveMonitorValues) {
+	*out = *in
+	in.MonitorDefaults.DeepCopyInto(&out.MonitorDefaults)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveMonitorValues.
+func (in *EffectiveMonitorValues) DeepCopy() *EffectiveMonitorValues {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveMonitorValues)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FormParam) DeepCopyInto(out *FormParam) {
+	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(ValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FormParam.
+func (in *FormParam) DeepCopy() *FormParam {
+	if in == nil {
+		return nil
+	}
+	out := new(FormParam)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GarbageCollection) DeepCopyInto(out *GarbageCollection) {
 	*out = *in
 	if in.Interval != nil {
 		in, out := &in.Interval, &out.Interval
 		*out = new(metav1.Duration)
 		**out = **in
 	}
-	out.Account = in.Account
-	in.Monitor.DeepCopyInto(&out.Monitor)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorSpec.
-func (in *MonitorSpec) DeepCopy() *MonitorSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollection.
+func (in *GarbageCollection) DeepCopy() *GarbageCollection {
 	if in == nil {
 		return nil
 	}
-	out := new(MonitorSpec)
+	out := new(GarbageCollection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MonitorStatus) DeepCopyInto(out *MonitorStatus) {
+func (in *Header) DeepCopyInto(out *Header) {
 	*out = *in
-	if in.SourceRef != nil {
-		in, out := &in.SourceRef, &out.SourceRef
-		*out = new(corev1.TypedLocalObjectReference)
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(ValueSource)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorStatus.
-func (in *MonitorStatus) DeepCopy() *MonitorStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Header.
+func (in *Header) DeepCopy() *Header {
 	if in == nil {
 		return nil
 	}
-	out := new(MonitorStatus)
+	out := new(Header)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MonitorValues) DeepCopyInto(out *MonitorValues) {
+func (in *Incident) DeepCopyInto(out *Incident) {
 	*out = *in
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(pulsetictypes.RequestType)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Incident.
+func (in *Incident) DeepCopy() *Incident {
+	if in == nil {
+		return nil
+	}
+	out := new(Incident)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Incident) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IncidentList) DeepCopyInto(out *IncidentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Incident, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncidentList.
+func (in *IncidentList) DeepCopy() *IncidentList {
+	if in == nil {
+		return nil
+	}
+	out := new(IncidentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IncidentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IncidentSpec) DeepCopyInto(out *IncidentSpec) {
+	*out = *in
+	out.Account = in.Account
+	if in.StatusPage != nil {
+		in, out := &in.StatusPage, &out.StatusPage
+		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
-	in.MonitorDefaults.DeepCopyInto(&out.MonitorDefaults)
+	if in.Monitors != nil {
+		in, out := &in.Monitors, &out.Monitors
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Updates != nil {
+		in, out := &in.Updates, &out.Updates
+		*out = make([]IncidentUpdate, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorValues.
-func (in *MonitorValues) DeepCopy() *MonitorValues {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncidentSpec.
+func (in *IncidentSpec) DeepCopy() *IncidentSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MonitorValues)
+	out := new(IncidentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IncidentStatus) DeepCopyInto(out *IncidentStatus) {
+	*out = *in
+	if in.ResolvedAt != nil {
+		in, out := &in.ResolvedAt, &out.ResolvedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncidentStatus.
+func (in *IncidentStatus) DeepCopy() *IncidentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IncidentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IncidentUpdate) DeepCopyInto(out *IncidentUpdate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncidentUpdate.
+func (in *IncidentUpdate) DeepCopy() *IncidentUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(IncidentUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LighthouseConfig) DeepCopyInto(out *LighthouseConfig) {
+	*out = *in
+	if in.MinScores != nil {
+		in, out := &in.MinScores, &out.MinScores
+		*out = new(LighthouseScores)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LighthouseConfig.
+func (in *LighthouseConfig) DeepCopy() *LighthouseConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LighthouseConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LighthouseScores) DeepCopyInto(out *LighthouseScores) {
+	*out = *in
+	if in.Performance != nil {
+		in, out := &in.Performance, &out.Performance
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Accessibility != nil {
+		in, out := &in.Accessibility, &out.Accessibility
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BestPractices != nil {
+		in, out := &in.BestPractices, &out.BestPractices
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SEO != nil {
+		in, out := &in.SEO, &out.SEO
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PWA != nil {
+		in, out := &in.PWA, &out.PWA
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LighthouseScores.
+func (in *LighthouseScores) DeepCopy() *LighthouseScores {
+	if in == nil {
+		return nil
+	}
+	out := new(LighthouseScores)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LighthouseStatus) DeepCopyInto(out *LighthouseStatus) {
+	*out = *in
+	in.Mobile.DeepCopyInto(&out.Mobile)
+	in.Desktop.DeepCopyInto(&out.Desktop)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LighthouseStatus.
+func (in *LighthouseStatus) DeepCopy() *LighthouseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LighthouseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MaintenanceWindow) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowList) DeepCopyInto(out *MaintenanceWindowList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowList.
+func (in *MaintenanceWindowList) DeepCopy() *MaintenanceWindowList {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MaintenanceWindowList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowSpec) DeepCopyInto(out *MaintenanceWindowSpec) {
+	*out = *in
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = (*in).DeepCopy()
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	in.Selector.DeepCopyInto(&out.Selector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowSpec.
+func (in *MaintenanceWindowSpec) DeepCopy() *MaintenanceWindowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowStatus) DeepCopyInto(out *MaintenanceWindowStatus) {
+	*out = *in
+	if in.CurrentStart != nil {
+		in, out := &in.CurrentStart, &out.CurrentStart
+		*out = (*in).DeepCopy()
+	}
+	if in.CurrentEnd != nil {
+		in, out := &in.CurrentEnd, &out.CurrentEnd
+		*out = (*in).DeepCopy()
+	}
+	if in.NextStart != nil {
+		in, out := &in.NextStart, &out.NextStart
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowStatus.
+func (in *MaintenanceWindowStatus) DeepCopy() *MaintenanceWindowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Monitor) DeepCopyInto(out *Monitor) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitor.
+func (in *Monitor) DeepCopy() *Monitor {
+	if in == nil {
+		return nil
+	}
+	out := new(Monitor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Monitor) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitorDefaults) DeepCopyInto(out *MonitorDefaults) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(pulsetictypes.RequestMethod)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.OfflineNotificationDelay != nil {
+		in, out := &in.OfflineNotificationDelay, &out.OfflineNotificationDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SSLCheck != nil {
+		in, out := &in.SSLCheck, &out.SSLCheck
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorDefaults.
+func (in *MonitorDefaults) DeepCopy() *MonitorDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitorDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitorList) DeepCopyInto(out *MonitorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Monitor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorList.
+func (in *MonitorList) DeepCopy() *MonitorList {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MonitorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitorRequest) DeepCopyInto(out *MonitorRequest) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]Header, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(RequestBody)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorRequest.
+func (in *MonitorRequest) DeepCopy() *MonitorRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitorRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitorResponse) DeepCopyInto(out *MonitorResponse) {
+	*out = *in
+	if in.ExpectedStatusCode != nil {
+		in, out := &in.ExpectedStatusCode, &out.ExpectedStatusCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]Header, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorResponse.
+func (in *MonitorResponse) DeepCopy() *MonitorResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitorResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitorSpec) DeepCopyInto(out *MonitorSpec) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	out.Account = in.Account
+	in.Monitor.DeepCopyInto(&out.Monitor)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorSpec.
+func (in *MonitorSpec) DeepCopy() *MonitorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitorStatus) DeepCopyInto(out *MonitorStatus) {
+	*out = *in
+	if in.Contacts != nil {
+		in, out := &in.Contacts, &out.Contacts
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseTime != nil {
+		in, out := &in.ResponseTime, &out.ResponseTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.LastCheckedAt != nil {
+		in, out := &in.LastCheckedAt, &out.LastCheckedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.StatusChangedAt != nil {
+		in, out := &in.StatusChangedAt, &out.StatusChangedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]NodeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SourceRef != nil {
+		in, out := &in.SourceRef, &out.SourceRef
+		*out = new(corev1.TypedLocalObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSLCertificate != nil {
+		in, out := &in.SSLCertificate, &out.SSLCertificate
+		*out = new(SSLCertificateStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lighthouse != nil {
+		in, out := &in.Lighthouse, &out.Lighthouse
+		*out = new(LighthouseStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Effective != nil {
+		in, out := &in.Effective, &out.Effective
+		*out = new(EffectiveMonitorValues)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorStatus.
+func (in *MonitorStatus) DeepCopy() *MonitorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitorValues) DeepCopyInto(out *MonitorValues) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(pulsetictypes.RequestType)
+		**out = **in
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(MonitorRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Response != nil {
+		in, out := &in.Response, &out.Response
+		*out = new(MonitorResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]ContactReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Lighthouse != nil {
+		in, out := &in.Lighthouse, &out.Lighthouse
+		*out = new(LighthouseConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	in.MonitorDefaults.DeepCopyInto(&out.MonitorDefaults)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorValues.
+func (in *MonitorValues) DeepCopy() *MonitorValues {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitorValues)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
+	*out = *in
+	if in.ResponseTime != nil {
+		in, out := &in.ResponseTime, &out.ResponseTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeStatus.
+func (in *NodeStatus) DeepCopy() *NodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestBody) DeepCopyInto(out *RequestBody) {
+	*out = *in
+	if in.RawFrom != nil {
+		in, out := &in.RawFrom, &out.RawFrom
+		*out = new(ValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JSONFrom != nil {
+		in, out := &in.JSONFrom, &out.JSONFrom
+		*out = new(ValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Form != nil {
+		in, out := &in.Form, &out.Form
+		*out = make([]FormParam, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestBody.
+func (in *RequestBody) DeepCopy() *RequestBody {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestBody)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSLCertificateStatus) DeepCopyInto(out *SSLCertificateStatus) {
+	*out = *in
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSLCertificateStatus.
+func (in *SSLCertificateStatus) DeepCopy() *SSLCertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SSLCertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusPage) DeepCopyInto(out *StatusPage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusPage.
+func (in *StatusPage) DeepCopy() *StatusPage {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusPage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StatusPage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusPageList) DeepCopyInto(out *StatusPageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StatusPage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusPageList.
+func (in *StatusPageList) DeepCopy() *StatusPageList {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusPageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StatusPageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusPageSection) DeepCopyInto(out *StatusPageSection) {
+	*out = *in
+	if in.Monitors != nil {
+		in, out := &in.Monitors, &out.Monitors
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusPageSection.
+func (in *StatusPageSection) DeepCopy() *StatusPageSection {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusPageSection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusPageSpec) DeepCopyInto(out *StatusPageSpec) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	out.Account = in.Account
+	in.Page.DeepCopyInto(&out.Page)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusPageSpec.
+func (in *StatusPageSpec) DeepCopy() *StatusPageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusPageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusPageStatus) DeepCopyInto(out *StatusPageStatus) {
+	*out = *in
+	if in.PendingMonitors != nil {
+		in, out := &in.PendingMonitors, &out.PendingMonitors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusPageStatus.
+func (in *StatusPageStatus) DeepCopy() *StatusPageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusPageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusPageValues) DeepCopyInto(out *StatusPageValues) {
+	*out = *in
+	if in.Sections != nil {
+		in, out := &in.Sections, &out.Sections
+		*out = make([]StatusPageSection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusPageValues.
+func (in *StatusPageValues) DeepCopy() *StatusPageValues {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusPageValues)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValueSource) DeepCopyInto(out *ValueSource) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValueSource.
+func (in *ValueSource) DeepCopy() *ValueSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ValueSource)
 	in.DeepCopyInto(out)
 	return out
 }
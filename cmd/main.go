@@ -21,9 +21,12 @@ import (
 	"flag"
 	"os"
 	"path/filepath"
+	"time"
 
 	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
 	"github.com/clevyr/pulsetic-operator/internal/controller"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	webhookv1 "github.com/clevyr/pulsetic-operator/internal/webhook/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -60,6 +63,7 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var statusPollInterval time.Duration
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -87,6 +91,33 @@ func main() {
 	flag.StringVar(&controller.AnnotationPrefix, "source-annotation-prefix", controller.AnnotationPrefix,
 		"Source annotation prefix",
 	)
+	flag.StringVar(&controller.ClusterName, "cluster-name", controller.ClusterName,
+		"Name of this cluster. If set, monitors are stamped with their owner so that multiple clusters can "+
+			"share one Pulsetic account without modifying each other's monitors.",
+	)
+	flag.DurationVar(&statusPollInterval, "status-poll-interval", 5*time.Minute,
+		"How often to refresh Monitor status from Pulsetic. Set to 0 to disable polling.",
+	)
+	flag.DurationVar(&pulsetic.DefaultOptions.Timeout, "pulsetic-timeout", pulsetic.DefaultOptions.Timeout,
+		"Timeout for each request to the Pulsetic API.",
+	)
+	flag.IntVar(&pulsetic.DefaultOptions.MaxRetries, "pulsetic-max-retries", pulsetic.DefaultOptions.MaxRetries,
+		"Number of times a Pulsetic API request is retried after a 429, 5xx, or network error.",
+	)
+	flag.DurationVar(&pulsetic.DefaultOptions.RetryBaseDelay, "pulsetic-retry-delay",
+		pulsetic.DefaultOptions.RetryBaseDelay,
+		"Delay before the first retry of a Pulsetic API request. It doubles with each retry.",
+	)
+	flag.DurationVar(&pulsetic.DefaultOptions.MaxRetryDelay, "pulsetic-max-retry-delay",
+		pulsetic.DefaultOptions.MaxRetryDelay,
+		"Maximum delay between retries of a Pulsetic API request, including delays requested by Retry-After.",
+	)
+	flag.Float64Var(&pulsetic.DefaultOptions.RateLimit, "pulsetic-rate-limit", pulsetic.DefaultOptions.RateLimit,
+		"Requests per second allowed to the Pulsetic API for each API key. Set to 0 to disable rate limiting.",
+	)
+	flag.IntVar(&pulsetic.DefaultOptions.Burst, "pulsetic-burst", pulsetic.DefaultOptions.Burst,
+		"Number of Pulsetic API requests that may be sent at once for each API key before the rate limit applies.",
+	)
 	opts := zap.Options{
 		Development: true,
 	}
@@ -249,8 +280,66 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "HTTPRoute")
 		os.Exit(1)
 	}
+	if err = (&controller.MaintenanceWindowReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("pulsetic-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "MaintenanceWindow")
+		os.Exit(1)
+	}
+	if err = (&controller.StatusPageReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("pulsetic-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "StatusPage")
+		os.Exit(1)
+	}
+	if err = (&controller.ContactReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("pulsetic-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Contact")
+		os.Exit(1)
+	}
+	if err = (&controller.IncidentReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("pulsetic-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Incident")
+		os.Exit(1)
+	}
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err = webhookv1.SetupMonitorWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Monitor")
+			os.Exit(1)
+		}
+		if err = webhookv1.SetupAccountWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Account")
+			os.Exit(1)
+		}
+		if err = webhookv1.SetupAccountBindingWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "AccountBinding")
+			os.Exit(1)
+		}
+	}
 	//+kubebuilder:scaffold:builder
 
+	if statusPollInterval > 0 {
+		if err := mgr.Add(&controller.MonitorStatusPoller{
+			Client:   mgr.GetClient(),
+			Recorder: mgr.GetEventRecorderFor("pulsetic-controller"),
+			Interval: statusPollInterval,
+		}); err != nil {
+			setupLog.Error(err, "unable to add monitor status poller to manager")
+			os.Exit(1)
+		}
+	}
+
 	if metricsCertWatcher != nil {
 		setupLog.Info("Adding metrics certificate watcher to manager")
 		if err := mgr.Add(metricsCertWatcher); err != nil {
@@ -0,0 +1,69 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/clevyr/pulsetic-operator/internal/util"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// checkNamespaceAllowed returns ErrNamespaceNotAllowed if the Account's allowedNamespaces selector
+// does not match namespace. Namespaces can be selected by name with the kubernetes.io/metadata.name label.
+func checkNamespaceAllowed(ctx context.Context, c client.Client, account *pulseticv1.Account, namespace string) error {
+	if account.Spec.AllowedNamespaces == nil {
+		return nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(account.Spec.AllowedNamespaces)
+	if err != nil {
+		return err
+	}
+
+	ns := &corev1.Namespace{}
+	if err := c.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		return err
+	}
+
+	if !selector.Matches(labels.Set(ns.Labels)) {
+		return fmt.Errorf("%w: %s/%s", ErrNamespaceNotAllowed, account.Name, namespace)
+	}
+	return nil
+}
+
+// mergeMonitorDefaults returns defaults with unset fields filled from base.
+func mergeMonitorDefaults(defaults, base *pulseticv1.MonitorDefaults) *pulseticv1.MonitorDefaults {
+	if defaults == nil {
+		return base
+	}
+	if base == nil {
+		return defaults
+	}
+	return &pulseticv1.MonitorDefaults{
+		Interval:                 util.FirstValue(defaults.Interval, base.Interval),
+		Method:                   util.FirstValue(defaults.Method, base.Method),
+		Timeout:                  util.FirstValue(defaults.Timeout, base.Timeout),
+		OfflineNotificationDelay: util.FirstValue(defaults.OfflineNotificationDelay, base.OfflineNotificationDelay),
+		SSLCheck:                 util.FirstValue(defaults.SSLCheck, base.SSLCheck),
+	}
+}
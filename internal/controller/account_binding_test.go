@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_mergeMonitorDefaults(t *testing.T) {
+	account := &pulseticv1.MonitorDefaults{
+		Interval: &metav1.Duration{Duration: time.Minute},
+		SSLCheck: ptr.To(true),
+	}
+	binding := &pulseticv1.MonitorDefaults{
+		Interval: &metav1.Duration{Duration: 5 * time.Minute},
+	}
+
+	assert.Same(t, account, mergeMonitorDefaults(nil, account))
+	assert.Same(t, binding, mergeMonitorDefaults(binding, nil))
+
+	got := mergeMonitorDefaults(binding, account)
+	assert.Equal(t, 5*time.Minute, got.Interval.Duration)
+	assert.Equal(t, ptr.To(true), got.SSLCheck)
+	assert.Nil(t, got.Timeout)
+}
+
+func Test_checkNamespaceAllowed(t *testing.T) {
+	c := fake.NewClientBuilder().WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"team": "b"}}},
+	).Build()
+
+	account := &pulseticv1.Account{ObjectMeta: metav1.ObjectMeta{Name: "example"}}
+	require.NoError(t, checkNamespaceAllowed(t.Context(), c, account, "team-b"))
+
+	account.Spec.AllowedNamespaces = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+	require.NoError(t, checkNamespaceAllowed(t.Context(), c, account, "team-a"))
+	require.ErrorIs(t, checkNamespaceAllowed(t.Context(), c, account, "team-b"), ErrNamespaceNotAllowed)
+}
@@ -20,10 +20,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
 	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
@@ -49,6 +53,8 @@ var ErrKeyNotFound = errors.New("secret key not found")
 //+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=accounts,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=accounts/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=accounts/finalizers,verbs=update
+//+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=accountbindings,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 //+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
@@ -65,34 +71,72 @@ func (r *AccountReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	apiKey, err := GetAPIKey(ctx, r.Client, account)
+	apiKey, err := GetAPIKey(ctx, r.Client, account, "")
 	if err != nil {
-		r.Recorder.Event(account, "Warning", "GetAPIKeyFailed", err.Error())
-		return ctrl.Result{}, err
+		return r.fail(ctx, account, "GetAPIKeyFailed", err)
 	}
 
 	psclient := pulsetic.NewClient(apiKey)
 	for _, err := range psclient.Monitors().List(ctx) {
 		if err != nil {
-			account.Status.Ready = false
-			if err := r.Status().Update(ctx, account); err != nil {
-				r.Recorder.Event(account, "Warning", "UpdateStatusFailed", err.Error())
-				return ctrl.Result{}, err
-			}
-
-			r.Recorder.Event(account, "Warning", "AuthenticationFailed", err.Error())
-			return ctrl.Result{}, err
+			return r.fail(ctx, account, "AuthenticationFailed", err)
 		}
 		break
 	}
 
+	var result ctrl.Result
+	if gc := account.Spec.GarbageCollection; gc != nil && gc.Enabled {
+		if orphans, err := r.collectGarbage(ctx, psclient, account); err != nil {
+			r.Recorder.Event(account, "Warning", "GarbageCollectionFailed", err.Error())
+		} else {
+			account.Status.OrphanedMonitors = orphans
+			account.Status.LastGarbageCollection = &metav1.Time{Time: time.Now()}
+		}
+		result.RequeueAfter = defaultGarbageCollectionInterval
+		if gc.Interval != nil {
+			result.RequeueAfter = gc.Interval.Duration
+		}
+	}
+
+	account.Status.ObservedGeneration = account.Generation
 	account.Status.Ready = true
+	meta.SetStatusCondition(&account.Status.Conditions, metav1.Condition{
+		Type:               pulseticv1.ConditionReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: account.Generation,
+		Reason:             "AuthenticationSucceeded",
+		Message:            "API key is valid",
+	})
 	if err := r.Status().Update(ctx, account); err != nil {
 		r.Recorder.Event(account, "Warning", "UpdateStatusFailed", err.Error())
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return result, nil
+}
+
+// fail records a Warning event, marks the Account as not ready, and returns err.
+func (r *AccountReconciler) fail(
+	ctx context.Context,
+	account *pulseticv1.Account,
+	reason string,
+	err error,
+) (ctrl.Result, error) {
+	r.Recorder.Event(account, "Warning", reason, err.Error())
+
+	account.Status.ObservedGeneration = account.Generation
+	account.Status.Ready = false
+	meta.SetStatusCondition(&account.Status.Conditions, metav1.Condition{
+		Type:               pulseticv1.ConditionReady,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: account.Generation,
+		Reason:             reason,
+		Message:            err.Error(),
+	})
+	if err := r.Status().Update(ctx, account); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update status")
+	}
+	return ctrl.Result{}, err
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -116,9 +160,50 @@ func (r *AccountReconciler) SetupWithManager(mgr ctrl.Manager) error {
 var (
 	ErrNoDefaultAccount       = errors.New("no default account")
 	ErrMultipleDefaultAccount = errors.New("more than 1 default account found")
+	ErrMultipleDefaultBinding = errors.New("more than 1 default account binding found")
+	ErrNamespaceNotAllowed    = errors.New("account is not allowed in namespace")
 )
 
-func GetAccount(ctx context.Context, c client.Client, account *pulseticv1.Account, name string) error {
+// GetAccount resolves the Account used by resources in namespace.
+// If name is empty, the namespace's default AccountBinding is used, falling back to the cluster default Account.
+// The Account's allowedNamespaces selector is enforced, and monitor defaults from an AccountBinding
+// for the Account in namespace are merged over the Account's own.
+func GetAccount(ctx context.Context, c client.Client, account *pulseticv1.Account, namespace, name string) error {
+	bindings := &pulseticv1.AccountBindingList{}
+	if err := c.List(ctx, bindings, client.InNamespace(namespace)); err != nil {
+		return err
+	}
+
+	if name == "" {
+		for _, binding := range bindings.Items {
+			if !binding.Spec.IsDefault {
+				continue
+			}
+			if name != "" {
+				return ErrMultipleDefaultBinding
+			}
+			name = binding.Spec.AccountRef.Name
+		}
+	}
+
+	if err := getClusterAccount(ctx, c, account, name); err != nil {
+		return err
+	}
+
+	if err := checkNamespaceAllowed(ctx, c, account, namespace); err != nil {
+		return err
+	}
+
+	for _, binding := range bindings.Items {
+		if binding.Spec.AccountRef.Name == account.Name {
+			account.Spec.MonitorDefaults = mergeMonitorDefaults(binding.Spec.MonitorDefaults, account.Spec.MonitorDefaults)
+			break
+		}
+	}
+	return nil
+}
+
+func getClusterAccount(ctx context.Context, c client.Client, account *pulseticv1.Account, name string) error {
 	if name != "" {
 		return c.Get(ctx, client.ObjectKey{Name: name}, account)
 	}
@@ -141,20 +226,55 @@ func GetAccount(ctx context.Context, c client.Client, account *pulseticv1.Accoun
 	return nil
 }
 
-func GetAPIKey(ctx context.Context, c client.Client, account *pulseticv1.Account) (string, error) {
+// monitorAccountName returns the name of the Account a Monitor was last synced with,
+// falling back to the Account in its spec. It is empty if the Monitor uses the default and hasn't synced yet.
+func monitorAccountName(monitor *pulseticv1.Monitor) string {
+	if monitor.Status.Effective != nil && monitor.Status.Effective.Account != "" {
+		return monitor.Status.Effective.Account
+	}
+	return monitor.Spec.Account.Name
+}
+
+// GetAPIKey returns the API key used for account's resources in namespace.
+// An AccountBinding in namespace can provide its own key. Otherwise, or if namespace is empty,
+// the Account's key is read from ClusterResourceNamespace.
+func GetAPIKey(ctx context.Context, c client.Client, account *pulseticv1.Account, namespace string) (string, error) {
+	if namespace != "" {
+		bindings := &pulseticv1.AccountBindingList{}
+		if err := c.List(ctx, bindings, client.InNamespace(namespace)); err != nil {
+			return "", err
+		}
+		for _, binding := range bindings.Items {
+			if binding.Spec.AccountRef.Name == account.Name && binding.Spec.APIKeySecretRef != nil {
+				return GetSecretValue(ctx, c, namespace, *binding.Spec.APIKeySecretRef)
+			}
+		}
+	}
+	return GetSecretValue(ctx, c, ClusterResourceNamespace, account.Spec.APIKeySecretRef)
+}
+
+func GetSecretValue(ctx context.Context, c client.Client, namespace string, ref corev1.SecretKeySelector) (string, error) {
+	optional := ref.Optional != nil && *ref.Optional
+
 	secret := &corev1.Secret{}
 	err := c.Get(ctx, client.ObjectKey{
-		Namespace: ClusterResourceNamespace,
-		Name:      account.Spec.APIKeySecretRef.Name,
+		Namespace: namespace,
+		Name:      ref.Name,
 	}, secret)
 	if err != nil {
+		if optional && apierrors.IsNotFound(err) {
+			return "", nil
+		}
 		return "", err
 	}
 
-	apiKey, ok := secret.Data[account.Spec.APIKeySecretRef.Key]
+	val, ok := secret.Data[ref.Key]
 	if !ok {
-		return "", fmt.Errorf("%w: %s", ErrKeyNotFound, account.Spec.APIKeySecretRef.Key)
+		if optional {
+			return "", nil
+		}
+		return "", fmt.Errorf("%w: %s", ErrKeyNotFound, ref.Key)
 	}
 
-	return string(apiKey), nil
+	return string(val), nil
 }
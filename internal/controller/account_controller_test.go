@@ -17,7 +17,21 @@ limitations under the License.
 package controller
 
 import (
+	"cmp"
+	"testing"
+	"time"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
 	. "github.com/onsi/ginkgo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var _ = Describe("Account Controller", func() {
@@ -29,3 +43,161 @@ var _ = Describe("Account Controller", func() {
 		})
 	})
 })
+
+func TestGetSecretValue(t *testing.T) {
+	c := fakeClientBuilder(t, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "example"},
+		Data:       map[string][]byte{"token": []byte("secret")},
+	}).Build()
+
+	selector := func(name, key string, optional bool) corev1.SecretKeySelector {
+		return corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: name},
+			Key:                  key,
+			Optional:             ptr.To(optional),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		ref     corev1.SecretKeySelector
+		want    string
+		wantErr require.ErrorAssertionFunc
+	}{
+		{"found", selector("example", "token", false), "secret", require.NoError},
+		{"missing key", selector("example", "missing", false), "", require.Error},
+		{"missing key optional", selector("example", "missing", true), "", require.NoError},
+		{"missing secret", selector("missing", "token", false), "", require.Error},
+		{"missing secret optional", selector("missing", "token", true), "", require.NoError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetSecretValue(t.Context(), c, "default", tt.ref)
+			tt.wantErr(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := GetSecretValue(t.Context(), c, "default", selector("example", "missing", false))
+	require.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+func TestGetAPIKey(t *testing.T) {
+	account, secret := newTestAccount(t, "example")
+	c := fakeClientBuilder(t, account, secret,
+		&pulseticv1.AccountBinding{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "example"},
+			Spec: pulseticv1.AccountBindingSpec{
+				AccountRef: corev1.LocalObjectReference{Name: "example"},
+				APIKeySecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "pulsetic"},
+					Key:                  "apiKey",
+				},
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "pulsetic"},
+			Data:       map[string][]byte{"apiKey": []byte("team-a")},
+		},
+		&pulseticv1.AccountBinding{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-b", Name: "example"},
+			Spec:       pulseticv1.AccountBindingSpec{AccountRef: corev1.LocalObjectReference{Name: "example"}},
+		},
+	).Build()
+
+	tests := []struct {
+		name      string
+		namespace string
+		want      string
+	}{
+		{"cluster", "", t.Name()},
+		{"binding with key", "team-a", "team-a"},
+		{"binding without key", "team-b", t.Name()},
+		{"unbound", "default", t.Name()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetAPIKey(t.Context(), c, account, tt.namespace)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAccountReconciler_Reconcile(t *testing.T) {
+	tests := []struct {
+		name       string
+		apiKey     string
+		withSecret bool
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		{"valid key", "", true, metav1.ConditionTrue, "AuthenticationSucceeded"},
+		{"invalid key", "invalid", true, metav1.ConditionFalse, "AuthenticationFailed"},
+		{"missing secret", "", false, metav1.ConditionFalse, "GetAPIKeyFailed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newFakePulsetic(t)
+
+			account := &pulseticv1.Account{
+				ObjectMeta: metav1.ObjectMeta{Name: "example", Generation: 3},
+				Spec: pulseticv1.AccountSpec{APIKeySecretRef: corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "pulsetic"},
+					Key:                  "apiKey",
+				}},
+			}
+			builder := fakeClientBuilder(t, account).WithStatusSubresource(account)
+			if tt.withSecret {
+				apiKey := cmp.Or(tt.apiKey, t.Name())
+				builder = builder.WithObjects(&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: ClusterResourceNamespace, Name: "pulsetic"},
+					Data:       map[string][]byte{"apiKey": []byte(apiKey)},
+				})
+			}
+			r := &AccountReconciler{Client: builder.Build(), Recorder: record.NewFakeRecorder(10)}
+
+			_, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(account)})
+			if tt.wantStatus == metav1.ConditionTrue {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+
+			got := &pulseticv1.Account{}
+			require.NoError(t, r.Get(t.Context(), client.ObjectKeyFromObject(account), got))
+			ready := meta.FindStatusCondition(got.Status.Conditions, pulseticv1.ConditionReady)
+			require.NotNil(t, ready)
+			assert.Equal(t, tt.wantStatus, ready.Status)
+			assert.Equal(t, tt.wantReason, ready.Reason)
+			assert.Equal(t, tt.wantStatus == metav1.ConditionTrue, got.Status.Ready)
+			assert.EqualValues(t, 3, got.Status.ObservedGeneration)
+		})
+	}
+}
+
+func TestAccountReconciler_Reconcile_garbageCollectionInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval *metav1.Duration
+		want     time.Duration
+	}{
+		{"default", nil, defaultGarbageCollectionInterval},
+		{"configured", &metav1.Duration{Duration: 10 * time.Minute}, 10 * time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newFakePulsetic(t)
+			account, secret := newTestAccount(t, "example")
+			account.Spec.GarbageCollection = &pulseticv1.GarbageCollection{Enabled: true, DryRun: true, Interval: tt.interval}
+			r := &AccountReconciler{
+				Client:   fakeClientBuilder(t, account, secret).WithStatusSubresource(account).Build(),
+				Recorder: record.NewFakeRecorder(10),
+			}
+
+			res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(account)})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, res.RequeueAfter)
+		})
+	}
+}
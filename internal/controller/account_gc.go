@@ -0,0 +1,122 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var ErrClusterNameRequired = errors.New("garbage collection requires --cluster-name to be set")
+
+// defaultGarbageCollectionInterval is used when an Account enables garbage collection without an interval.
+const defaultGarbageCollectionInterval = time.Hour
+
+// collectGarbage finds Pulsetic monitors created by this cluster that no longer belong to a Monitor resource.
+// Monitors whose Monitor resource disables pruning are never collected.
+// Only the Account's own API key is listed, so monitors created with an AccountBinding's key are not collected.
+// Orphans are deleted unless the Account is in dry-run mode. It returns the number of orphans found.
+func (r *AccountReconciler) collectGarbage(
+	ctx context.Context,
+	c pulsetic.Client,
+	account *pulseticv1.Account,
+) (int32, error) {
+	if ClusterName == "" {
+		return 0, ErrClusterNameRequired
+	}
+
+	logger := log.FromContext(ctx)
+	dryRun := account.Spec.GarbageCollection.DryRun
+
+	var orphans []pulsetic.Monitor
+	for psmonitor, err := range c.Monitors().List(ctx) {
+		if err != nil {
+			return 0, err
+		}
+
+		// Monitors with a keep marker belong to a Monitor that doesn't allow pruning.
+		owner, prune, ok := parseOwnerMarker(psmonitor.Name)
+		if !ok || !prune || owner.Cluster != ClusterName {
+			continue
+		}
+
+		orphaned, err := r.isOrphaned(ctx, account, psmonitor, owner)
+		if err != nil {
+			return 0, err
+		}
+		if orphaned {
+			orphans = append(orphans, psmonitor)
+		}
+	}
+
+	var errs []error
+	for _, psmonitor := range orphans {
+		id := strconv.FormatInt(psmonitor.ID, 10)
+		if dryRun {
+			r.Recorder.Event(account, "Warning", "OrphanedMonitorFound",
+				"Pulsetic monitor "+id+" ("+psmonitor.Name+") has no Monitor resource",
+			)
+			continue
+		}
+
+		if err := c.Monitors().Delete(ctx, psmonitor.ID); err != nil {
+			r.Recorder.Event(account, "Warning", "DeleteOrphanedMonitorFailed",
+				"Failed to delete Pulsetic monitor "+id+": "+err.Error(),
+			)
+			errs = append(errs, err)
+			continue
+		}
+		logger.Info("Deleted orphaned monitor", "id", psmonitor.ID, "name", psmonitor.Name)
+		r.Recorder.Event(account, "Normal", "DeleteOrphanedMonitorSucceeded",
+			"Deleted orphaned Pulsetic monitor "+id+" ("+psmonitor.Name+")",
+		)
+	}
+
+	return int32(len(orphans)), errors.Join(errs...) //nolint:gosec
+}
+
+// isOrphaned reports whether a Pulsetic monitor owned by this cluster is no longer managed by its Monitor resource.
+// A monitor is orphaned if its Monitor was deleted, was moved to a different Account,
+// or now tracks a different Pulsetic monitor.
+func (r *AccountReconciler) isOrphaned(
+	ctx context.Context,
+	account *pulseticv1.Account,
+	psmonitor pulsetic.Monitor,
+	owner MonitorOwner,
+) (bool, error) {
+	monitor := &pulseticv1.Monitor{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: owner.Namespace, Name: owner.Name}, monitor); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	if name := monitorAccountName(monitor); name != "" && name != account.Name {
+		return true, nil
+	}
+
+	return monitor.Status.ID != 0 && monitor.Status.ID != psmonitor.ID, nil
+}
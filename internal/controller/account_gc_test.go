@@ -0,0 +1,110 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestAccountReconciler_collectGarbage(t *testing.T) {
+	clusterName := ClusterName
+	ClusterName = "prod"
+	t.Cleanup(func() { ClusterName = clusterName })
+
+	account := &pulseticv1.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Spec:       pulseticv1.AccountSpec{GarbageCollection: &pulseticv1.GarbageCollection{Enabled: true}},
+	}
+	monitor := func(name, account string, id int64) *pulseticv1.Monitor {
+		return &pulseticv1.Monitor{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec:       pulseticv1.MonitorSpec{Account: corev1.LocalObjectReference{Name: account}, Prune: true},
+			Status:     pulseticv1.MonitorStatus{ID: id},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		remote      string
+		objects     []*pulseticv1.Monitor
+		wantDeleted bool
+	}{
+		{"managed", "Example [k8s:prod/default/example]", []*pulseticv1.Monitor{monitor("example", "", 1)}, false},
+		{"not found", "Example [k8s:prod/default/example]", nil, true},
+		{"account moved", "Example [k8s:prod/default/example]", []*pulseticv1.Monitor{monitor("example", "other", 1)}, true},
+		{"id changed", "Example [k8s:prod/default/example]", []*pulseticv1.Monitor{monitor("example", "", 2)}, true},
+		{"prune false", "Example [k8s-keep:prod/default/example]", nil, false},
+		{"other cluster", "Example [k8s:staging/default/example]", nil, false},
+		{"no marker", "Example", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := fakeClientBuilder(t)
+			for _, obj := range tt.objects {
+				builder = builder.WithObjects(obj)
+			}
+			r := &AccountReconciler{Client: builder.Build(), Recorder: record.NewFakeRecorder(10)}
+			fake, psclient := newFakePulsetic(t, pulsetic.Monitor{ID: 1, Name: tt.remote, URL: "https://example.com"})
+
+			orphans, err := r.collectGarbage(t.Context(), psclient, account)
+			require.NoError(t, err)
+			if tt.wantDeleted {
+				assert.EqualValues(t, 1, orphans)
+				assert.Equal(t, []int64{1}, fake.Deleted())
+			} else {
+				assert.Zero(t, orphans)
+				assert.Empty(t, fake.Deleted())
+			}
+		})
+	}
+}
+
+func TestAccountReconciler_collectGarbage_dryRun(t *testing.T) {
+	clusterName := ClusterName
+	ClusterName = "prod"
+	t.Cleanup(func() { ClusterName = clusterName })
+
+	account := &pulseticv1.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Spec:       pulseticv1.AccountSpec{GarbageCollection: &pulseticv1.GarbageCollection{DryRun: true}},
+	}
+	r := &AccountReconciler{Client: fakeClientBuilder(t).Build(), Recorder: record.NewFakeRecorder(10)}
+	fake, psclient := newFakePulsetic(t, pulsetic.Monitor{ID: 1, Name: "Example [k8s:prod/default/example]"})
+
+	orphans, err := r.collectGarbage(t.Context(), psclient, account)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, orphans)
+	assert.Empty(t, fake.Deleted())
+}
+
+func TestAccountReconciler_collectGarbage_requiresClusterName(t *testing.T) {
+	clusterName := ClusterName
+	ClusterName = ""
+	t.Cleanup(func() { ClusterName = clusterName })
+
+	r := &AccountReconciler{Client: fakeClientBuilder(t).Build(), Recorder: record.NewFakeRecorder(10)}
+	_, err := r.collectGarbage(t.Context(), pulsetic.Client{}, &pulseticv1.Account{})
+	require.ErrorIs(t, err, ErrClusterNameRequired)
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const contactSecretRefField = "spec.contact.secretRef"
+
+// ContactReconciler reconciles a Contact object.
+type ContactReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+//+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=contacts,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=contacts/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=contacts/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+// Reconcile creates or updates the Pulsetic contact for a Contact.
+func (r *ContactReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	_ = log.FromContext(ctx)
+
+	contact := &pulseticv1.Contact{}
+	if err := r.Get(ctx, req.NamespacedName, contact); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	account := &pulseticv1.Account{}
+	if err := GetAccount(ctx, r.Client, account, contact.Namespace, contact.Spec.Account.Name); err != nil {
+		return r.fail(ctx, contact, "GetAccountFailed", err)
+	}
+
+	apiKey, err := GetAPIKey(ctx, r.Client, account, contact.Namespace)
+	if err != nil {
+		r.Recorder.Event(account, "Warning", "GetAPIKeyFailed", err.Error())
+		return r.fail(ctx, contact, "GetAPIKeyFailed", err)
+	}
+	psclient := pulsetic.NewClient(apiKey)
+
+	const myFinalizerName = "pulsetic.clevyr.com/finalizer"
+	if !contact.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(contact, myFinalizerName) {
+			if contact.Spec.Prune && contact.Status.ID != 0 {
+				if err := psclient.Contacts().Delete(ctx, contact.Status.ID); err != nil && !pulsetic.IsNotFound(err) {
+					return r.fail(ctx, contact, "DeleteContactFailed", err)
+				}
+				r.Recorder.Event(contact, "Normal", "DeleteContactSucceeded",
+					"Deleted contact "+strconv.Quote(contact.Name),
+				)
+			}
+
+			controllerutil.RemoveFinalizer(contact, myFinalizerName)
+			if err := r.Update(ctx, contact); err != nil {
+				r.Recorder.Event(contact, "Warning", "RemoveFinalizerFailed", err.Error())
+				return ctrl.Result{}, err
+			}
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	values := contact.Spec.Contact
+	if values.Name == "" {
+		values.Name = contact.Name
+	}
+	if values.ValueFrom != nil && values.ValueFrom.SecretKeyRef != nil {
+		if values.Value, err = GetSecretValue(ctx, r.Client, contact.Namespace, *values.ValueFrom.SecretKeyRef); err != nil {
+			return r.fail(ctx, contact, "ResolveValuesFailed", err)
+		}
+	}
+	desired := values.ToContact()
+
+	id := contact.Status.ID
+	if values.ID != 0 {
+		id = values.ID
+	}
+
+	var pscontact pulsetic.Contact
+	err = pulsetic.ErrContactNotFound
+	if id != 0 {
+		pscontact, err = psclient.Contacts().Update(ctx, id, desired)
+	}
+
+	var reason, message string
+	switch {
+	case err == nil:
+		reason = "UpdateContactSucceeded"
+		message = "Updated contact " + strconv.Quote(contact.Name) + " in " + time.Since(start).String()
+	case errors.Is(err, pulsetic.ErrContactNotFound) && values.ID == 0:
+		if pscontact, err = psclient.Contacts().Create(ctx, desired); err != nil {
+			return r.fail(ctx, contact, "CreateContactFailed", err)
+		}
+		reason = "CreateContactSucceeded"
+		message = "Created contact " + strconv.Quote(contact.Name) + " in " + time.Since(start).String()
+	default:
+		return r.fail(ctx, contact, "UpdateContactFailed", err)
+	}
+	r.Recorder.Event(contact, "Normal", reason, message+", next run in "+contact.Spec.Interval.Duration.String())
+
+	contact.Status.ObservedGeneration = contact.Generation
+	contact.Status.ID = pscontact.ID
+	for _, conditionType := range []string{pulseticv1.ConditionSynced, pulseticv1.ConditionReady} {
+		meta.SetStatusCondition(&contact.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: contact.Generation,
+			Reason:             reason,
+			Message:            message,
+		})
+	}
+	if err := r.Status().Update(ctx, contact); err != nil {
+		r.Recorder.Event(contact, "Warning", "UpdateStatusFailed", err.Error())
+		return ctrl.Result{}, err
+	}
+
+	if !controllerutil.ContainsFinalizer(contact, myFinalizerName) {
+		controllerutil.AddFinalizer(contact, myFinalizerName)
+		if err := r.Update(ctx, contact); err != nil {
+			r.Recorder.Event(contact, "Warning", "AddFinalizerFailed", err.Error())
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: contact.Spec.Interval.Duration}, nil
+}
+
+// fail records a Warning event, marks the Contact as not ready, and returns err.
+func (r *ContactReconciler) fail(
+	ctx context.Context,
+	contact *pulseticv1.Contact,
+	reason string,
+	err error,
+) (ctrl.Result, error) {
+	r.Recorder.Event(contact, "Warning", reason, err.Error())
+
+	contact.Status.ObservedGeneration = contact.Generation
+	for _, conditionType := range []string{pulseticv1.ConditionSynced, pulseticv1.ConditionReady} {
+		meta.SetStatusCondition(&contact.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: contact.Generation,
+			Reason:             reason,
+			Message:            err.Error(),
+		})
+	}
+	if err := r.Status().Update(ctx, contact); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update status")
+	}
+	return ctrl.Result{}, err
+}
+
+func (r *ContactReconciler) findContactsForSecret(ctx context.Context, secret client.Object) []reconcile.Request {
+	list := &pulseticv1.ContactList{}
+	if err := r.List(ctx, list,
+		client.InNamespace(secret.GetNamespace()),
+		client.MatchingFields{contactSecretRefField: secret.GetName()},
+	); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, contact := range list.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&contact)})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ContactReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &pulseticv1.Contact{}, contactSecretRefField, func(rawObj client.Object) []string {
+		contact := rawObj.(*pulseticv1.Contact) //nolint:errcheck
+		if from := contact.Spec.Contact.ValueFrom; from != nil && from.SecretKeyRef != nil {
+			return []string{from.SecretKeyRef.Name}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&pulseticv1.Contact{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.findContactsForSecret),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		Named("contact").
+		Complete(r)
+}
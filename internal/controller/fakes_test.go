@@ -0,0 +1,382 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic/pulsetictypes"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakePulsetic is an in-memory Pulsetic API used by controller tests.
+// Updates only change a monitor's name and URL.
+type fakePulsetic struct {
+	mu          sync.Mutex
+	monitors    []pulsetic.Monitor
+	deleted     []int64
+	actions     []string
+	statusPages []pulsetic.StatusPage
+	incidents   []pulsetic.Incident
+	nextID      int64
+}
+
+// newFakePulsetic starts a fake Pulsetic API with the given monitors
+// and returns a client that talks to it.
+// The API key is the test name, and requests with any other key are rejected.
+func newFakePulsetic(t *testing.T, monitors ...pulsetic.Monitor) (*fakePulsetic, pulsetic.Client) {
+	t.Helper()
+	for i := range monitors {
+		// The enums can't be encoded as their zero values.
+		if !monitors[i].RequestType.IsARequestType() {
+			monitors[i].RequestType = pulsetictypes.RequestTypeHTTP
+		}
+		if !monitors[i].RequestMethod.IsARequestMethod() {
+			monitors[i].RequestMethod = pulsetictypes.MethodGET
+		}
+	}
+	f := &fakePulsetic{monitors: monitors, nextID: 100}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /monitors", f.listMonitors)
+	mux.HandleFunc("GET /nodes", f.listNodes)
+	mux.HandleFunc("GET /monitors/{id}", f.getMonitor)
+	mux.HandleFunc("PUT /monitors/{id}", f.updateMonitor)
+	mux.HandleFunc("DELETE /monitors/{id}", f.deleteMonitor)
+	mux.HandleFunc("POST /monitors/{id}/{action}", f.monitorAction)
+	mux.HandleFunc("POST /status-pages", f.createStatusPage)
+	mux.HandleFunc("GET /status-pages/{id}", f.getStatusPage)
+	mux.HandleFunc("PUT /status-pages/{id}", f.updateStatusPage)
+	mux.HandleFunc("POST /incidents", f.createIncident)
+	mux.HandleFunc("PUT /incidents/{id}", f.updateIncident)
+	mux.HandleFunc("POST /incidents/{id}/updates", f.addIncidentUpdate)
+
+	apiKey := t.Name()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != apiKey {
+			http.Error(w, "Unauthenticated.", http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	t.Setenv("PULSETIC_API", srv.URL)
+
+	opts := pulsetic.DefaultOptions
+	pulsetic.DefaultOptions.RateLimit = 0
+	pulsetic.DefaultOptions.MaxRetries = 0
+	t.Cleanup(func() { pulsetic.DefaultOptions = opts })
+
+	return f, pulsetic.NewClient(apiKey)
+}
+
+// Monitor returns the monitor with the given ID.
+func (f *fakePulsetic) Monitor(id int64) (pulsetic.Monitor, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	i := f.indexOf(strconv.FormatInt(id, 10))
+	if i == -1 {
+		return pulsetic.Monitor{}, false
+	}
+	return f.monitors[i], true
+}
+
+// Actions returns the monitor actions sent through the API, formatted as "<id>/<action>".
+func (f *fakePulsetic) Actions() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.actions)
+}
+
+// Deleted returns the IDs of the monitors deleted through the API.
+func (f *fakePulsetic) Deleted() []int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.deleted)
+}
+
+func (f *fakePulsetic) listMonitors(w http.ResponseWriter, _ *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	writeJSON(w, pulsetic.ListResponse{CurrentPage: 1, LastPage: 1, Data: f.monitors})
+}
+
+// fakeNodes are the check nodes served by the fake API.
+var fakeNodes = []pulsetic.Node{
+	{ID: 1, Title: "US East", Location: "us-east"},
+	{ID: 2, Title: "EU West", Location: "eu-west"},
+}
+
+func (f *fakePulsetic) listNodes(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, pulsetic.NodesResponse{Data: fakeNodes})
+}
+
+func (f *fakePulsetic) getMonitor(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	i := f.indexOf(r.PathValue("id"))
+	if i == -1 {
+		http.NotFound(w, r)
+		return
+	}
+	writeJSON(w, pulsetic.UpdateResponse{Data: f.monitors[i]})
+}
+
+func (f *fakePulsetic) updateMonitor(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	i := f.indexOf(r.PathValue("id"))
+	if i == -1 {
+		http.NotFound(w, r)
+		return
+	}
+	var params pulsetic.MonitorEditParams
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	f.monitors[i].Name = params.Name
+	f.monitors[i].URL = params.URL
+	writeJSON(w, pulsetic.UpdateResponse{Data: f.monitors[i]})
+}
+
+func (f *fakePulsetic) deleteMonitor(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	i := f.indexOf(r.PathValue("id"))
+	if i == -1 {
+		http.NotFound(w, r)
+		return
+	}
+	f.deleted = append(f.deleted, f.monitors[i].ID)
+	f.monitors = slices.Delete(f.monitors, i, i+1)
+	writeJSON(w, struct{}{})
+}
+
+func (f *fakePulsetic) monitorAction(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	i := f.indexOf(r.PathValue("id"))
+	if i == -1 {
+		http.NotFound(w, r)
+		return
+	}
+	action := r.PathValue("action")
+	switch action {
+	case "start":
+		f.monitors[i].IsRunning = true
+	case "stop":
+		f.monitors[i].IsRunning = false
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	f.actions = append(f.actions, r.PathValue("id")+"/"+action)
+	writeJSON(w, struct{}{})
+}
+
+// StatusPages returns the status pages created through the API.
+func (f *fakePulsetic) StatusPages() []pulsetic.StatusPage {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.statusPages)
+}
+
+func (f *fakePulsetic) createStatusPage(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var params pulsetic.StatusPageEditParams
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	f.nextID++
+	page := statusPageFromParams(f.nextID, params)
+	f.statusPages = append(f.statusPages, page)
+	writeJSON(w, pulsetic.StatusPageResponse{Data: page})
+}
+
+func (f *fakePulsetic) getStatusPage(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	i := f.indexOfStatusPage(r.PathValue("id"))
+	if i == -1 {
+		http.NotFound(w, r)
+		return
+	}
+	writeJSON(w, pulsetic.StatusPageResponse{Data: f.statusPages[i]})
+}
+
+func (f *fakePulsetic) updateStatusPage(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	i := f.indexOfStatusPage(r.PathValue("id"))
+	if i == -1 {
+		http.NotFound(w, r)
+		return
+	}
+	var params pulsetic.StatusPageEditParams
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	f.statusPages[i] = statusPageFromParams(f.statusPages[i].ID, params)
+	writeJSON(w, pulsetic.StatusPageResponse{Data: f.statusPages[i]})
+}
+
+func (f *fakePulsetic) indexOfStatusPage(id string) int {
+	return slices.IndexFunc(f.statusPages, func(p pulsetic.StatusPage) bool {
+		return strconv.FormatInt(p.ID, 10) == id
+	})
+}
+
+func statusPageFromParams(id int64, params pulsetic.StatusPageEditParams) pulsetic.StatusPage {
+	return pulsetic.StatusPage{
+		ID:           id,
+		Title:        params.Title,
+		URL:          "https://status.example.com/" + strconv.FormatInt(id, 10),
+		CustomDomain: params.CustomDomain,
+		LogoURL:      params.LogoURL,
+		Sections:     params.Sections,
+	}
+}
+
+// Incidents returns the incidents created through the API.
+func (f *fakePulsetic) Incidents() []pulsetic.Incident {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.incidents)
+}
+
+func (f *fakePulsetic) createIncident(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var params pulsetic.IncidentEditParams
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	f.nextID++
+	incident := pulsetic.Incident{
+		ID:           f.nextID,
+		Title:        params.Title,
+		Severity:     params.Severity,
+		Status:       pulsetic.IncidentStatusInvestigating,
+		StatusPageID: params.StatusPageID,
+		Monitors:     params.Monitors,
+	}
+	f.incidents = append(f.incidents, incident)
+	writeJSON(w, pulsetic.IncidentResponse{Data: incident})
+}
+
+func (f *fakePulsetic) updateIncident(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	i := f.indexOfIncident(r.PathValue("id"))
+	if i == -1 {
+		http.NotFound(w, r)
+		return
+	}
+	var params pulsetic.IncidentEditParams
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	f.incidents[i].Title = params.Title
+	f.incidents[i].Severity = params.Severity
+	f.incidents[i].StatusPageID = params.StatusPageID
+	f.incidents[i].Monitors = params.Monitors
+	writeJSON(w, pulsetic.IncidentResponse{Data: f.incidents[i]})
+}
+
+func (f *fakePulsetic) addIncidentUpdate(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	i := f.indexOfIncident(r.PathValue("id"))
+	if i == -1 {
+		http.NotFound(w, r)
+		return
+	}
+	var update pulsetic.IncidentUpdate
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	f.nextID++
+	update.ID = f.nextID
+	f.incidents[i].Status = update.Status
+	f.incidents[i].Updates = append(f.incidents[i].Updates, update)
+	writeJSON(w, pulsetic.IncidentResponse{Data: f.incidents[i]})
+}
+
+func (f *fakePulsetic) indexOfIncident(id string) int {
+	return slices.IndexFunc(f.incidents, func(i pulsetic.Incident) bool {
+		return strconv.FormatInt(i.ID, 10) == id
+	})
+}
+
+func (f *fakePulsetic) indexOf(id string) int {
+	return slices.IndexFunc(f.monitors, func(m pulsetic.Monitor) bool {
+		return strconv.FormatInt(m.ID, 10) == id
+	})
+}
+
+// newTestAccount returns an Account and the Secret holding its API key,
+// which is accepted by the API started with newFakePulsetic.
+func newTestAccount(t *testing.T, name string) (*pulseticv1.Account, *corev1.Secret) {
+	t.Helper()
+	account := &pulseticv1.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: pulseticv1.AccountSpec{APIKeySecretRef: corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: name + "-api-key"},
+			Key:                  "apiKey",
+		}},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ClusterResourceNamespace, Name: name + "-api-key"},
+		Data:       map[string][]byte{"apiKey": []byte(t.Name())},
+	}
+	return account, secret
+}
+
+// fakeClientBuilder returns a builder for a fake Kubernetes client that knows the operator's types.
+func fakeClientBuilder(t *testing.T, objs ...client.Object) *fake.ClientBuilder {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, pulseticv1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
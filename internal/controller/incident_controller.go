@@ -0,0 +1,275 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// IncidentReconciler reconciles an Incident object.
+type IncidentReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+var (
+	ErrMonitorNotReady    = errors.New("monitor has not been created in Pulsetic")
+	ErrStatusPageNotReady = errors.New("status page has not been created in Pulsetic")
+)
+
+//+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=incidents,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=incidents/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=incidents/finalizers,verbs=update
+//+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=monitors,verbs=get;list;watch
+//+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=statuspages,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+// Reconcile publishes an Incident and its timeline to Pulsetic, resolving it when requested or deleted.
+func (r *IncidentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	incident := &pulseticv1.Incident{}
+	if err := r.Get(ctx, req.NamespacedName, incident); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	account := &pulseticv1.Account{}
+	if err := GetAccount(ctx, r.Client, account, incident.Namespace, incident.Spec.Account.Name); err != nil {
+		return r.fail(ctx, incident, "GetAccountFailed", err)
+	}
+
+	apiKey, err := GetAPIKey(ctx, r.Client, account, incident.Namespace)
+	if err != nil {
+		r.Recorder.Event(account, "Warning", "GetAPIKeyFailed", err.Error())
+		return r.fail(ctx, incident, "GetAPIKeyFailed", err)
+	}
+	psclient := pulsetic.NewClient(apiKey)
+
+	const myFinalizerName = "pulsetic.clevyr.com/finalizer"
+	if !incident.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(incident, myFinalizerName) {
+			if incident.Status.ID != 0 && !incident.Status.Resolved {
+				_, err := psclient.Incidents().Resolve(ctx, incident.Status.ID, incident.Spec.ResolvedMessage)
+				if err != nil && !pulsetic.IsNotFound(err) {
+					return r.fail(ctx, incident, "ResolveIncidentFailed", err)
+				}
+				r.Recorder.Event(incident, "Normal", "ResolveIncidentSucceeded",
+					"Resolved incident "+strconv.Quote(incident.Name)+" before deletion",
+				)
+			}
+
+			controllerutil.RemoveFinalizer(incident, myFinalizerName)
+			if err := r.Update(ctx, incident); err != nil {
+				r.Recorder.Event(incident, "Warning", "RemoveFinalizerFailed", err.Error())
+				return ctrl.Result{}, err
+			}
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(incident, myFinalizerName) {
+		controllerutil.AddFinalizer(incident, myFinalizerName)
+		if err := r.Update(ctx, incident); err != nil {
+			r.Recorder.Event(incident, "Warning", "AddFinalizerFailed", err.Error())
+			return ctrl.Result{}, err
+		}
+	}
+
+	if incident.Status.Resolved {
+		// Pulsetic can't reopen a resolved incident, so report it instead of silently ignoring the change.
+		if !incident.Spec.Resolved {
+			const message = "A resolved incident cannot be reopened; create a new Incident instead"
+			r.Recorder.Event(incident, "Warning", "ReopenNotSupported", message)
+			return r.setConditions(ctx, incident, metav1.ConditionFalse, "ReopenNotSupported", message)
+		}
+		return r.setConditions(ctx, incident, metav1.ConditionTrue, "IncidentResolved", "Incident is resolved")
+	}
+
+	desired, err := r.desiredIncident(ctx, incident)
+	if err != nil {
+		return r.fail(ctx, incident, "ResolveReferencesFailed", err)
+	}
+
+	if incident.Status.ID != 0 {
+		_, err := psclient.Incidents().Update(ctx, incident.Status.ID, desired)
+		switch {
+		case errors.Is(err, pulsetic.ErrIncidentNotFound):
+			// Retrying can't bring back an incident that was deleted in Pulsetic, so report it instead.
+			message := "Incident " + strconv.FormatInt(incident.Status.ID, 10) + " was not found in Pulsetic"
+			r.Recorder.Event(incident, "Warning", "IncidentNotFound", message)
+			return r.setConditions(ctx, incident, metav1.ConditionFalse, "IncidentNotFound", message)
+		case err != nil:
+			return r.fail(ctx, incident, "UpdateIncidentFailed", err)
+		}
+	} else {
+		psincident, err := psclient.Incidents().Create(ctx, desired)
+		if err != nil {
+			return r.fail(ctx, incident, "CreateIncidentFailed", err)
+		}
+
+		// Persist the ID right away so a later failure doesn't create the incident again.
+		patch := client.MergeFrom(incident.DeepCopy())
+		incident.Status.ID = psincident.ID
+		if err := r.Status().Patch(ctx, incident, patch); err != nil {
+			r.Recorder.Event(incident, "Warning", "UpdateStatusFailed", err.Error())
+			return ctrl.Result{}, err
+		}
+		r.Recorder.Event(incident, "Normal", "CreateIncidentSucceeded",
+			"Created incident "+strconv.Quote(incident.Spec.Title),
+		)
+	}
+
+	// Post new timeline updates one at a time so a failure resumes from the right place.
+	for i := int(incident.Status.PublishedUpdates); i < len(incident.Spec.Updates); i++ {
+		update := incident.Spec.Updates[i]
+		if _, err := psclient.Incidents().AddUpdate(ctx, incident.Status.ID, pulsetic.IncidentUpdate{
+			Status:  update.State.ToPulsetic(),
+			Message: update.Message,
+		}); err != nil {
+			return r.fail(ctx, incident, "AddIncidentUpdateFailed", err)
+		}
+		incident.Status.PublishedUpdates = int32(i + 1) //nolint:gosec
+		r.Recorder.Event(incident, "Normal", "AddIncidentUpdateSucceeded", string(update.State)+": "+update.Message)
+	}
+
+	reason, message := "IncidentOpen", "Incident is open"
+	if incident.Spec.Resolved {
+		if _, err := psclient.Incidents().Resolve(ctx, incident.Status.ID, incident.Spec.ResolvedMessage); err != nil {
+			return r.fail(ctx, incident, "ResolveIncidentFailed", err)
+		}
+		incident.Status.Resolved = true
+		incident.Status.ResolvedAt = &metav1.Time{Time: time.Now()}
+		reason, message = "IncidentResolved", "Incident is resolved"
+		r.Recorder.Event(incident, "Normal", "ResolveIncidentSucceeded",
+			"Resolved incident "+strconv.Quote(incident.Spec.Title),
+		)
+	}
+
+	return r.setConditions(ctx, incident, metav1.ConditionTrue, reason, message)
+}
+
+// desiredIncident builds the Pulsetic incident, resolving the Monitors and StatusPage it references.
+func (r *IncidentReconciler) desiredIncident(
+	ctx context.Context,
+	incident *pulseticv1.Incident,
+) (pulsetic.Incident, error) {
+	desired := pulsetic.Incident{
+		Title:    incident.Spec.Title,
+		Severity: incident.Spec.Severity.ToPulsetic(),
+		Monitors: make([]int64, 0, len(incident.Spec.Monitors)),
+	}
+
+	for _, ref := range incident.Spec.Monitors {
+		monitor := &pulseticv1.Monitor{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: incident.Namespace, Name: ref.Name}, monitor); err != nil {
+			return desired, fmt.Errorf("monitor %s: %w", ref.Name, err)
+		}
+		if monitor.Status.ID == 0 {
+			return desired, fmt.Errorf("monitor %s: %w", ref.Name, ErrMonitorNotReady)
+		}
+		desired.Monitors = append(desired.Monitors, monitor.Status.ID)
+	}
+
+	if ref := incident.Spec.StatusPage; ref != nil {
+		page := &pulseticv1.StatusPage{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: incident.Namespace, Name: ref.Name}, page); err != nil {
+			return desired, fmt.Errorf("status page %s: %w", ref.Name, err)
+		}
+		if page.Status.ID == 0 {
+			return desired, fmt.Errorf("status page %s: %w", ref.Name, ErrStatusPageNotReady)
+		}
+		desired.StatusPageID = page.Status.ID
+	}
+
+	return desired, nil
+}
+
+// setConditions sets the Synced and Ready conditions and updates the Incident's status.
+func (r *IncidentReconciler) setConditions(
+	ctx context.Context,
+	incident *pulseticv1.Incident,
+	status metav1.ConditionStatus,
+	reason, message string,
+) (ctrl.Result, error) {
+	incident.Status.ObservedGeneration = incident.Generation
+	for _, conditionType := range []string{pulseticv1.ConditionSynced, pulseticv1.ConditionReady} {
+		meta.SetStatusCondition(&incident.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             status,
+			ObservedGeneration: incident.Generation,
+			Reason:             reason,
+			Message:            message,
+		})
+	}
+	if err := r.Status().Update(ctx, incident); err != nil {
+		r.Recorder.Event(incident, "Warning", "UpdateStatusFailed", err.Error())
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// fail records a Warning event, marks the Incident as not ready, and returns err.
+func (r *IncidentReconciler) fail(
+	ctx context.Context,
+	incident *pulseticv1.Incident,
+	reason string,
+	err error,
+) (ctrl.Result, error) {
+	r.Recorder.Event(incident, "Warning", reason, err.Error())
+
+	incident.Status.ObservedGeneration = incident.Generation
+	for _, conditionType := range []string{pulseticv1.ConditionSynced, pulseticv1.ConditionReady} {
+		meta.SetStatusCondition(&incident.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: incident.Generation,
+			Reason:             reason,
+			Message:            err.Error(),
+		})
+	}
+	if err := r.Status().Update(ctx, incident); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update status")
+	}
+	return ctrl.Result{}, err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *IncidentReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&pulseticv1.Incident{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("incident").
+		Complete(r)
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func newTestIncident(updates ...pulseticv1.IncidentUpdate) *pulseticv1.Incident {
+	return &pulseticv1.Incident{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "outage", Generation: 1},
+		Spec: pulseticv1.IncidentSpec{
+			Account:         corev1.LocalObjectReference{Name: "example"},
+			Title:           "Outage",
+			Severity:        pulseticv1.IncidentSeverityMajor,
+			Monitors:        []corev1.LocalObjectReference{{Name: "api"}},
+			Updates:         updates,
+			ResolvedMessage: "Fixed",
+		},
+	}
+}
+
+func newIncidentReconciler(t *testing.T, incident *pulseticv1.Incident, funcs interceptor.Funcs) *IncidentReconciler {
+	t.Helper()
+	account, secret := newTestAccount(t, "example")
+	c := fakeClientBuilder(t, account, secret, incident,
+		&pulseticv1.Monitor{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "api"},
+			Status:     pulseticv1.MonitorStatus{ID: 1},
+		},
+	).
+		WithStatusSubresource(incident).
+		WithInterceptorFuncs(funcs).
+		Build()
+	return &IncidentReconciler{Client: c, Recorder: record.NewFakeRecorder(20)}
+}
+
+func getIncident(t *testing.T, r *IncidentReconciler) *pulseticv1.Incident {
+	t.Helper()
+	incident := &pulseticv1.Incident{}
+	require.NoError(t, r.Get(t.Context(), client.ObjectKey{Namespace: "default", Name: "outage"}, incident))
+	return incident
+}
+
+func reconcileIncident(t *testing.T, r *IncidentReconciler) error {
+	t.Helper()
+	_, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKey{Namespace: "default", Name: "outage"}})
+	return err
+}
+
+func TestIncidentReconciler_Reconcile(t *testing.T) {
+	fake, _ := newFakePulsetic(t)
+	r := newIncidentReconciler(t, newTestIncident(
+		pulseticv1.IncidentUpdate{State: pulseticv1.IncidentStateInvestigating, Message: "Looking into it"},
+	), interceptor.Funcs{})
+
+	require.NoError(t, reconcileIncident(t, r))
+	incidents := fake.Incidents()
+	require.Len(t, incidents, 1)
+	assert.Equal(t, "Outage", incidents[0].Title)
+	assert.Equal(t, pulsetic.IncidentSeverityMajor, incidents[0].Severity)
+	assert.Equal(t, []int64{1}, incidents[0].Monitors)
+	require.Len(t, incidents[0].Updates, 1)
+
+	got := getIncident(t, r)
+	assert.Equal(t, incidents[0].ID, got.Status.ID)
+	assert.EqualValues(t, 1, got.Status.PublishedUpdates)
+	assert.True(t, meta.IsStatusConditionTrue(got.Status.Conditions, pulseticv1.ConditionReady))
+
+	// Appended updates are posted without creating the incident again.
+	got.Spec.Updates = append(got.Spec.Updates,
+		pulseticv1.IncidentUpdate{State: pulseticv1.IncidentStateMonitoring, Message: "Fix deployed"},
+	)
+	require.NoError(t, r.Update(t.Context(), got))
+	require.NoError(t, reconcileIncident(t, r))
+	incidents = fake.Incidents()
+	require.Len(t, incidents, 1)
+	require.Len(t, incidents[0].Updates, 2)
+	assert.Equal(t, pulsetic.IncidentStatusMonitoring, incidents[0].Status)
+
+	// Resolving posts the resolved message once.
+	got = getIncident(t, r)
+	got.Spec.Resolved = true
+	require.NoError(t, r.Update(t.Context(), got))
+	require.NoError(t, reconcileIncident(t, r))
+	require.NoError(t, reconcileIncident(t, r))
+	incidents = fake.Incidents()
+	require.Len(t, incidents[0].Updates, 3)
+	assert.Equal(t, pulsetic.IncidentUpdate{
+		ID: incidents[0].Updates[2].ID, Status: pulsetic.IncidentStatusResolved, Message: "Fixed",
+	}, incidents[0].Updates[2])
+	got = getIncident(t, r)
+	assert.True(t, got.Status.Resolved)
+	assert.NotNil(t, got.Status.ResolvedAt)
+
+	// Reopening is reported without retrying.
+	got.Spec.Resolved = false
+	got.Generation++
+	require.NoError(t, r.Update(t.Context(), got))
+	require.NoError(t, reconcileIncident(t, r))
+	assert.Len(t, fake.Incidents()[0].Updates, 3)
+	ready := meta.FindStatusCondition(getIncident(t, r).Status.Conditions, pulseticv1.ConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, "ReopenNotSupported", ready.Reason)
+}
+
+func TestIncidentReconciler_Reconcile_statusUpdateFailed(t *testing.T) {
+	fake, _ := newFakePulsetic(t)
+	failed := false
+	r := newIncidentReconciler(t, newTestIncident(), interceptor.Funcs{
+		SubResourceUpdate: func(
+			ctx context.Context,
+			c client.Client,
+			subResourceName string,
+			obj client.Object,
+			opts ...client.SubResourceUpdateOption,
+		) error {
+			if !failed {
+				failed = true
+				return errors.New("conflict")
+			}
+			return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+		},
+	})
+
+	require.Error(t, reconcileIncident(t, r))
+	require.Len(t, fake.Incidents(), 1)
+
+	require.NoError(t, reconcileIncident(t, r))
+	incidents := fake.Incidents()
+	require.Len(t, incidents, 1)
+	assert.Equal(t, incidents[0].ID, getIncident(t, r).Status.ID)
+}
+
+func TestIncidentReconciler_Reconcile_monitorNotReady(t *testing.T) {
+	fake, _ := newFakePulsetic(t)
+	incident := newTestIncident()
+	incident.Spec.Monitors = append(incident.Spec.Monitors, corev1.LocalObjectReference{Name: "missing"})
+	r := newIncidentReconciler(t, incident, interceptor.Funcs{})
+
+	require.Error(t, reconcileIncident(t, r))
+	assert.Empty(t, fake.Incidents())
+	ready := meta.FindStatusCondition(getIncident(t, r).Status.Conditions, pulseticv1.ConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, "ResolveReferencesFailed", ready.Reason)
+}
+
+func TestIncidentReconciler_Reconcile_notFound(t *testing.T) {
+	fake, _ := newFakePulsetic(t)
+	incident := newTestIncident()
+	incident.Status.ID = 42
+	r := newIncidentReconciler(t, incident, interceptor.Funcs{})
+
+	require.NoError(t, reconcileIncident(t, r))
+	assert.Empty(t, fake.Incidents())
+	got := getIncident(t, r)
+	assert.EqualValues(t, 42, got.Status.ID)
+	ready := meta.FindStatusCondition(got.Status.Conditions, pulseticv1.ConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, "IncidentNotFound", ready.Reason)
+}
@@ -0,0 +1,359 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"time"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	"github.com/robfig/cron/v3"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// MaintenanceWindowReconciler reconciles a MaintenanceWindow object.
+type MaintenanceWindowReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+var ErrDurationRequired = errors.New("duration is required")
+
+//+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=maintenancewindows,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=maintenancewindows/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=maintenancewindows/finalizers,verbs=update
+//+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=monitors,verbs=get;list;watch
+//+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=monitors/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+// Reconcile opens and closes maintenance windows, pausing the selected Monitors while a window is active.
+func (r *MaintenanceWindowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	_ = log.FromContext(ctx)
+
+	window := &pulseticv1.MaintenanceWindow{}
+	if err := r.Get(ctx, req.NamespacedName, window); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	const myFinalizerName = "pulsetic.clevyr.com/finalizer"
+	if !window.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(window, myFinalizerName) {
+			if _, err := r.applyWindow(ctx, window, false, labels.Nothing()); err != nil {
+				r.Recorder.Event(window, "Warning", "ResumeMonitorsFailed", err.Error())
+				return ctrl.Result{}, err
+			}
+
+			controllerutil.RemoveFinalizer(window, myFinalizerName)
+			if err := r.Update(ctx, window); err != nil {
+				r.Recorder.Event(window, "Warning", "RemoveFinalizerFailed", err.Error())
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(window, myFinalizerName) {
+		controllerutil.AddFinalizer(window, myFinalizerName)
+		if err := r.Update(ctx, window); err != nil {
+			r.Recorder.Event(window, "Warning", "AddFinalizerFailed", err.Error())
+			return ctrl.Result{}, err
+		}
+	}
+
+	now := time.Now()
+	period, err := currentPeriod(window.Spec, now)
+	if err != nil {
+		return r.fail(ctx, window, "InvalidSchedule", err)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&window.Spec.Selector)
+	if err != nil {
+		return r.fail(ctx, window, "InvalidSelector", err)
+	}
+
+	count, err := r.applyWindow(ctx, window, period.Active, selector)
+	if err != nil {
+		return r.fail(ctx, window, "PauseMonitorsFailed", err)
+	}
+
+	window.Status.ObservedGeneration = window.Generation
+	window.Status.Active = period.Active
+	window.Status.CurrentStart = timeOrNil(period.Start)
+	window.Status.CurrentEnd = timeOrNil(period.End)
+	window.Status.NextStart = timeOrNil(period.Next)
+	window.Status.Monitors = count
+
+	reason, message := "WindowInactive", "Maintenance window is not active"
+	if period.Active {
+		reason = "WindowActive"
+		message = "Paused " + strconv.Itoa(int(count)) + " monitors until " + period.End.Format(time.RFC3339)
+	}
+	meta.SetStatusCondition(&window.Status.Conditions, metav1.Condition{
+		Type:               pulseticv1.ConditionReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: window.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+	if err := r.Status().Update(ctx, window); err != nil {
+		r.Recorder.Event(window, "Warning", "UpdateStatusFailed", err.Error())
+		return ctrl.Result{}, err
+	}
+
+	var result ctrl.Result
+	switch {
+	case period.Active:
+		result.RequeueAfter = period.End.Sub(now)
+	case !period.Next.IsZero():
+		result.RequeueAfter = period.Next.Sub(now)
+	}
+	return result, nil
+}
+
+// fail records a Warning event, marks the MaintenanceWindow as not ready, and returns err.
+func (r *MaintenanceWindowReconciler) fail(
+	ctx context.Context,
+	window *pulseticv1.MaintenanceWindow,
+	reason string,
+	err error,
+) (ctrl.Result, error) {
+	r.Recorder.Event(window, "Warning", reason, err.Error())
+
+	window.Status.ObservedGeneration = window.Generation
+	meta.SetStatusCondition(&window.Status.Conditions, metav1.Condition{
+		Type:               pulseticv1.ConditionReady,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: window.Generation,
+		Reason:             reason,
+		Message:            err.Error(),
+	})
+	if err := r.Status().Update(ctx, window); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update status")
+	}
+	return ctrl.Result{}, err
+}
+
+// applyWindow adds the window to the status of every selected Monitor while it is active,
+// and removes it from all other Monitors. It returns the number of Monitors in the window.
+func (r *MaintenanceWindowReconciler) applyWindow(
+	ctx context.Context,
+	window *pulseticv1.MaintenanceWindow,
+	active bool,
+	selector labels.Selector,
+) (int32, error) {
+	list := &pulseticv1.MonitorList{}
+	if err := r.List(ctx, list, client.InNamespace(window.Namespace)); err != nil {
+		return 0, err
+	}
+
+	var count int32
+	var errs []error
+	for i := range list.Items {
+		monitor := &list.Items[i]
+		if !monitor.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		inWindow := active && selector.Matches(labels.Set(monitor.Labels))
+		if inWindow {
+			count++
+		}
+
+		if err := r.setMonitorWindow(ctx, window, monitor, inWindow); err != nil {
+			r.Recorder.Event(monitor, "Warning", "MaintenanceWindowFailed", err.Error())
+			errs = append(errs, err)
+		}
+	}
+	return count, errors.Join(errs...)
+}
+
+// setMonitorWindow pauses or resumes the Pulsetic monitor as it enters or leaves the window,
+// then records the change in the Monitor's status.
+func (r *MaintenanceWindowReconciler) setMonitorWindow(
+	ctx context.Context,
+	window *pulseticv1.MaintenanceWindow,
+	monitor *pulseticv1.Monitor,
+	inWindow bool,
+) error {
+	if slices.Contains(monitor.Status.MaintenanceWindows, window.Name) == inWindow {
+		return nil
+	}
+
+	wasPaused := monitor.Paused()
+	if inWindow {
+		monitor.Status.MaintenanceWindows = append(monitor.Status.MaintenanceWindows, window.Name)
+	} else {
+		monitor.Status.MaintenanceWindows = slices.DeleteFunc(monitor.Status.MaintenanceWindows, func(name string) bool {
+			return name == window.Name
+		})
+	}
+
+	if paused := monitor.Paused(); paused != wasPaused && monitor.Status.ID != 0 {
+		psclient, err := r.pulseticClient(ctx, monitor)
+		if err != nil {
+			return err
+		}
+
+		if paused {
+			if err := psclient.Monitors().Pause(ctx, monitor.Status.ID); err != nil {
+				return err
+			}
+			r.Recorder.Event(monitor, "Normal", "MaintenanceStarted",
+				"Paused checks for maintenance window "+strconv.Quote(window.Name),
+			)
+		} else {
+			if err := psclient.Monitors().Resume(ctx, monitor.Status.ID); err != nil {
+				return err
+			}
+			r.Recorder.Event(monitor, "Normal", "MaintenanceEnded",
+				"Resumed checks after maintenance window "+strconv.Quote(window.Name),
+			)
+		}
+		monitor.Status.Running = !paused
+		monitor.Status.Replicas = 0
+		if !paused {
+			monitor.Status.Replicas = 1
+		}
+	}
+
+	return r.Status().Update(ctx, monitor)
+}
+
+func (r *MaintenanceWindowReconciler) pulseticClient(
+	ctx context.Context,
+	monitor *pulseticv1.Monitor,
+) (pulsetic.Client, error) {
+	account := &pulseticv1.Account{}
+	if err := GetAccount(ctx, r.Client, account, monitor.Namespace, monitor.Spec.Account.Name); err != nil {
+		return pulsetic.Client{}, err
+	}
+
+	apiKey, err := GetAPIKey(ctx, r.Client, account, monitor.Namespace)
+	if err != nil {
+		return pulsetic.Client{}, err
+	}
+	return pulsetic.NewClient(apiKey), nil
+}
+
+// maintenancePeriod describes the current and next occurrence of a MaintenanceWindow.
+type maintenancePeriod struct {
+	Active     bool
+	Start, End time.Time
+	Next       time.Time
+}
+
+// currentPeriod evaluates a MaintenanceWindow's schedule at now.
+func currentPeriod(spec pulseticv1.MaintenanceWindowSpec, now time.Time) (maintenancePeriod, error) {
+	if spec.Start != nil {
+		start := spec.Start.Time
+		var end time.Time
+		switch {
+		case spec.End != nil:
+			end = spec.End.Time
+		case spec.Duration != nil:
+			end = start.Add(spec.Duration.Duration)
+		default:
+			return maintenancePeriod{}, ErrDurationRequired
+		}
+
+		switch {
+		case now.Before(start):
+			return maintenancePeriod{Next: start}, nil
+		case now.Before(end):
+			return maintenancePeriod{Active: true, Start: start, End: end}, nil
+		default:
+			return maintenancePeriod{}, nil
+		}
+	}
+
+	if spec.Duration == nil {
+		return maintenancePeriod{}, ErrDurationRequired
+	}
+	duration := spec.Duration.Duration
+
+	schedule, err := cron.ParseStandard(spec.Schedule)
+	if err != nil {
+		return maintenancePeriod{}, err
+	}
+
+	loc := time.UTC
+	if spec.TimeZone != "" {
+		if loc, err = time.LoadLocation(spec.TimeZone); err != nil {
+			return maintenancePeriod{}, err
+		}
+	}
+
+	// Walk every occurrence that started within the last duration. The latest one decides when the window ends.
+	var period maintenancePeriod
+	t := now.In(loc).Add(-duration)
+	for {
+		start := schedule.Next(t)
+		if start.IsZero() || start.After(now) {
+			period.Next = start
+			return period, nil
+		}
+		period.Active = true
+		period.Start = start
+		period.End = start.Add(duration)
+		t = start
+	}
+}
+
+// findWindowsForMonitor enqueues the MaintenanceWindows in a Monitor's namespace.
+func (r *MaintenanceWindowReconciler) findWindowsForMonitor(ctx context.Context, obj client.Object) []reconcile.Request {
+	list := &pulseticv1.MaintenanceWindowList{}
+	if err := r.List(ctx, list, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list maintenance windows")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, window := range list.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&window)})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *MaintenanceWindowReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&pulseticv1.MaintenanceWindow{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(
+			&pulseticv1.Monitor{},
+			handler.EnqueueRequestsFromMapFunc(r.findWindowsForMonitor),
+			builder.WithPredicates(predicate.LabelChangedPredicate{}),
+		).
+		Named("maintenancewindow").
+		Complete(r)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_currentPeriod(t *testing.T) {
+	// Sunday
+	now := time.Date(2025, time.January, 5, 2, 30, 0, 0, time.UTC)
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, time.January, day, hour, minute, 0, 0, time.UTC)
+	}
+	hour := &metav1.Duration{Duration: time.Hour}
+
+	tests := []struct {
+		name    string
+		spec    pulseticv1.MaintenanceWindowSpec
+		want    maintenancePeriod
+		wantErr require.ErrorAssertionFunc
+	}{
+		{
+			"fixed before start",
+			pulseticv1.MaintenanceWindowSpec{Start: &metav1.Time{Time: at(5, 3, 0)}, Duration: hour},
+			maintenancePeriod{Next: at(5, 3, 0)},
+			require.NoError,
+		},
+		{
+			"fixed active",
+			pulseticv1.MaintenanceWindowSpec{Start: &metav1.Time{Time: at(5, 2, 0)}, End: &metav1.Time{Time: at(5, 4, 0)}},
+			maintenancePeriod{Active: true, Start: at(5, 2, 0), End: at(5, 4, 0)},
+			require.NoError,
+		},
+		{
+			"fixed ended",
+			pulseticv1.MaintenanceWindowSpec{Start: &metav1.Time{Time: at(5, 1, 0)}, Duration: hour},
+			maintenancePeriod{},
+			require.NoError,
+		},
+		{
+			"schedule active",
+			pulseticv1.MaintenanceWindowSpec{Schedule: "0 2 * * sun", Duration: hour},
+			maintenancePeriod{Active: true, Start: at(5, 2, 0), End: at(5, 3, 0), Next: at(12, 2, 0)},
+			require.NoError,
+		},
+		{
+			"schedule inactive",
+			pulseticv1.MaintenanceWindowSpec{Schedule: "0 1 * * *", Duration: hour},
+			maintenancePeriod{Next: at(6, 1, 0)},
+			require.NoError,
+		},
+		{
+			"schedule overlapping",
+			pulseticv1.MaintenanceWindowSpec{Schedule: "*/20 * * * *", Duration: hour},
+			maintenancePeriod{Active: true, Start: at(5, 2, 20), End: at(5, 3, 20), Next: at(5, 2, 40)},
+			require.NoError,
+		},
+		{
+			"schedule missing duration",
+			pulseticv1.MaintenanceWindowSpec{Schedule: "0 2 * * *"},
+			maintenancePeriod{},
+			require.Error,
+		},
+		{
+			"invalid schedule",
+			pulseticv1.MaintenanceWindowSpec{Schedule: "bad", Duration: hour},
+			maintenancePeriod{},
+			require.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := currentPeriod(tt.spec, now)
+			tt.wantErr(t, err)
+			assert.True(t, tt.want.Start.Equal(got.Start), "start")
+			assert.True(t, tt.want.End.Equal(got.End), "end")
+			assert.True(t, tt.want.Next.Equal(got.Next), "next")
+			assert.Equal(t, tt.want.Active, got.Active)
+		})
+	}
+}
@@ -19,21 +19,33 @@ package controller
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
 	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+const statusIDField = "status.id"
+
+var ErrUnsupportedValue = errors.New("unsupported value")
+
 // MonitorReconciler reconciles a Monitor object.
 type MonitorReconciler struct {
 	client.Client
@@ -44,6 +56,7 @@ type MonitorReconciler struct {
 //+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=monitors,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=monitors/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=monitors/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -60,21 +73,15 @@ func (r *MonitorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	if monitor.Spec.Suspend {
-		return ctrl.Result{}, nil
-	}
-
 	account := &pulseticv1.Account{}
-	if err := GetAccount(ctx, r.Client, account, monitor.Spec.Account.Name); err != nil {
-		r.Recorder.Event(monitor, "Warning", "GetAccountFailed", err.Error())
-		return ctrl.Result{}, err
+	if err := GetAccount(ctx, r.Client, account, monitor.Namespace, monitor.Spec.Account.Name); err != nil {
+		return r.fail(ctx, monitor, pulseticv1.ConditionAccountResolved, "GetAccountFailed", err)
 	}
 
-	apiKey, err := GetAPIKey(ctx, r.Client, account)
+	apiKey, err := GetAPIKey(ctx, r.Client, account, monitor.Namespace)
 	if err != nil {
-		r.Recorder.Event(monitor, "Warning", "GetAPIKeyFailed", err.Error())
 		r.Recorder.Event(account, "Warning", "GetAPIKeyFailed", err.Error())
-		return ctrl.Result{}, err
+		return r.fail(ctx, monitor, pulseticv1.ConditionAccountResolved, "GetAPIKeyFailed", err)
 	}
 	psclient := pulsetic.NewClient(apiKey)
 
@@ -82,15 +89,14 @@ func (r *MonitorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	if !monitor.DeletionTimestamp.IsZero() {
 		// Object is being deleted
 		if controllerutil.ContainsFinalizer(monitor, myFinalizerName) {
-			if monitor.Spec.Prune && monitor.Status.Ready {
-				if err := psclient.Monitors().Delete(ctx, monitor.Status.ID); err != nil {
-					r.Recorder.Event(monitor, "Warning", "DeleteMonitorFailed", err.Error())
-					return ctrl.Result{}, err
+			if monitor.Status.ID != 0 {
+				if monitor.Spec.Prune {
+					if err := r.deleteMonitor(ctx, psclient, monitor); err != nil {
+						return r.fail(ctx, monitor, pulseticv1.ConditionSynced, "DeleteMonitorFailed", err)
+					}
+				} else if err := r.releaseMonitor(ctx, psclient, monitor); err != nil {
+					return r.fail(ctx, monitor, pulseticv1.ConditionSynced, "ReleaseMonitorFailed", err)
 				}
-
-				r.Recorder.Event(monitor, "Normal", "DeleteMonitorSucceeded",
-					"Deleted monitor "+strconv.Quote(monitor.Name)+" in "+time.Since(start).String(),
-				)
 			}
 
 			controllerutil.RemoveFinalizer(monitor, myFinalizerName)
@@ -103,44 +109,135 @@ func (r *MonitorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{}, nil
 	}
 
-	psmonitor, err := tryFindMonitor(ctx, psclient, monitor.Status.ID, monitor.Spec.Monitor.URL)
+	values, err := ResolveMonitorValues(ctx, r.Client, monitor.Namespace, monitor.Spec.Monitor)
 	if err != nil {
-		if !errors.Is(err, pulsetic.ErrMonitorNotFound) {
-			r.Recorder.Event(monitor, "Warning", "FindMonitorFailed", err.Error())
-			return ctrl.Result{}, err
+		return r.fail(ctx, monitor, pulseticv1.ConditionSynced, "ResolveValuesFailed", err)
+	}
+	contacts, err := resolveContacts(ctx, r.Client, monitor, account.Name)
+	if err != nil {
+		if errors.Is(err, ErrContactAccountMismatch) {
+			return r.invalid(ctx, monitor, err.Error())
+		}
+		return r.fail(ctx, monitor, pulseticv1.ConditionSynced, "ResolveContactsFailed", err)
+	}
+	effective := values.EffectiveDefaults(account.Spec.MonitorDefaults)
+	if snapped := effective.Snap(); len(snapped) != 0 {
+		msgs := make([]string, 0, len(snapped))
+		for _, v := range snapped {
+			msgs = append(msgs, v.String())
+		}
+		msg := strings.Join(msgs, ", ")
+		if monitor.Spec.UnsupportedValuePolicy == pulseticv1.UnsupportedValuePolicyReject {
+			// Retrying can't help until the spec changes.
+			return r.invalid(ctx, monitor, fmt.Errorf("%w: %s", ErrUnsupportedValue, msg).Error())
+		}
+		r.Recorder.Event(monitor, "Warning", "ValueSnapped", msg)
+	}
+	values.MonitorDefaults = effective
+	desired := values.ToMonitor(nil)
+	desired.Name = withOwnerMarker(desired.Name, ownerOf(monitor), monitor.Spec.Prune)
+	if len(values.Regions) != 0 {
+		nodes, err := psclient.Nodes().List(ctx)
+		if err != nil {
+			return r.fail(ctx, monitor, pulseticv1.ConditionSynced, "ListNodesFailed", err)
+		}
+		if desired.Nodes, err = resolveRegions(nodes, values.Regions); err != nil {
+			return r.invalid(ctx, monitor, err.Error())
 		}
+	}
+	desiredHash := desired.EditParams().Hash()
 
-		psmonitor, err = psclient.Monitors().Create(ctx, monitor.Spec.Monitor.ToMonitor(account.Spec.MonitorDefaults))
+	var syncReason, syncMessage string
+	psmonitor, err := r.findMonitor(ctx, psclient, monitor)
+	switch {
+	case err == nil:
+		owner, err := r.findOwner(ctx, monitor, psmonitor.ID)
 		if err != nil {
-			r.Recorder.Event(monitor, "Warning", "CreateMonitorFailed", err.Error())
-			return ctrl.Result{}, err
+			return r.fail(ctx, monitor, pulseticv1.ConditionSynced, "FindMonitorFailed", err)
+		}
+		if owner != "" {
+			return r.conflict(ctx, monitor, "MonitorAlreadyClaimed",
+				"Pulsetic monitor "+strconv.FormatInt(psmonitor.ID, 10)+" is already managed by "+owner,
+			)
+		}
+		if owner, owned := ownedByOther(psmonitor.Name, monitor); owned {
+			return r.conflict(ctx, monitor, "MonitorOwnedByOther",
+				"Pulsetic monitor "+strconv.FormatInt(psmonitor.ID, 10)+" is owned by "+owner.String(),
+			)
+		}
+
+		diff := desired.EditParams().Diff(psmonitor.EditParams())
+		if !r.checkDrift(monitor, desiredHash, diff) {
+			syncReason = "UpdateSkipped"
+			syncMessage = "No update needed for monitor " + strconv.Quote(monitor.Name)
+			break
+		}
+
+		psmonitor, err = psclient.Monitors().Update(ctx, psmonitor.ID, desired)
+		if err != nil {
+			if msg, ok := monitorValidationMessage(err); ok {
+				return r.invalid(ctx, monitor, msg)
+			}
+			return r.fail(ctx, monitor, pulseticv1.ConditionSynced, "UpdateMonitorFailed", err)
 		}
-		r.Recorder.Event(monitor, "Normal",
-			"CreateMonitorSucceeded",
-			"Created monitor "+strconv.Quote(monitor.Name)+" in "+time.Since(start).String()+
-				", next run in "+monitor.Spec.Interval.Duration.String(),
+		syncReason = "UpdateMonitorSucceeded"
+		syncMessage = "Updated monitor " + strconv.Quote(monitor.Name) + " in " + time.Since(start).String()
+		r.Recorder.Event(monitor, "Normal", syncReason,
+			syncMessage+", next run in "+monitor.Spec.Interval.Duration.String(),
 		)
-	} else {
-		psmonitor, err = psclient.Monitors().Update(ctx, psmonitor.ID, monitor.Spec.Monitor.ToMonitor(account.Spec.MonitorDefaults))
+	case !errors.Is(err, pulsetic.ErrMonitorNotFound):
+		return r.fail(ctx, monitor, pulseticv1.ConditionSynced, "FindMonitorFailed", err)
+	case monitor.Spec.Adopt == pulseticv1.AdoptPolicyAlways || monitor.Spec.Monitor.ID != 0:
+		return r.fail(ctx, monitor, pulseticv1.ConditionSynced, "AdoptMonitorFailed", err)
+	default:
+		psmonitor, err = psclient.Monitors().Create(ctx, desired)
 		if err != nil {
-			r.Recorder.Event(monitor, "Warning", "UpdateMonitorFailed", err.Error())
-			return ctrl.Result{}, err
+			if msg, ok := monitorValidationMessage(err); ok {
+				return r.invalid(ctx, monitor, msg)
+			}
+			return r.fail(ctx, monitor, pulseticv1.ConditionSynced, "CreateMonitorFailed", err)
 		}
+		syncReason = "CreateMonitorSucceeded"
+		syncMessage = "Created monitor " + strconv.Quote(monitor.Name) + " in " + time.Since(start).String()
+		r.Recorder.Event(monitor, "Normal", syncReason,
+			syncMessage+", next run in "+monitor.Spec.Interval.Duration.String(),
+		)
+	}
 
-		r.Recorder.Event(monitor, "Normal", "UpdateMonitorSucceeded", "Updated monitor "+strconv.Quote(monitor.Name)+" in "+time.Since(start).String()+", next run in "+monitor.Spec.Interval.Duration.String())
+	if err := r.syncRunning(ctx, psclient, monitor, &psmonitor); err != nil {
+		return r.fail(ctx, monitor, pulseticv1.ConditionSynced, "SetRunningFailed", err)
 	}
 
-	if monitor.Spec.Account.Name == "" {
-		monitor.Spec.Account.Name = account.Name
-		if err := r.Update(ctx, monitor); err != nil {
-			r.Recorder.Event(monitor, "Warning", "UpdateMonitorFailed", err.Error())
-			return ctrl.Result{}, err
+	if !slices.Equal(contacts, monitor.Status.Contacts) || (psmonitor.ID != monitor.Status.ID && len(contacts) != 0) {
+		if err := psclient.Monitors().SetContacts(ctx, psmonitor.ID, contacts); err != nil {
+			return r.fail(ctx, monitor, pulseticv1.ConditionSynced, "SetContactsFailed", err)
 		}
+		monitor.Status.Contacts = contacts
 	}
 
-	monitor.Status.Ready = true
-	monitor.Status.ID = psmonitor.ID
-	monitor.Status.Running = psmonitor.IsRunning
+	monitor.Status.ObservedGeneration = monitor.Generation
+	if syncReason != "UpdateSkipped" {
+		monitor.Status.LastAppliedHash = desiredHash
+	}
+	monitor.Status.Effective = &pulseticv1.EffectiveMonitorValues{
+		Account:         account.Name,
+		MonitorDefaults: effective,
+	}
+	setCheckStatus(monitor, psmonitor)
+	setMonitorCondition(monitor, pulseticv1.ConditionConflict, metav1.ConditionFalse,
+		"NoConflict", "Monitor is not managed by another resource",
+	)
+	setMonitorCondition(monitor, pulseticv1.ConditionInvalidSpec, metav1.ConditionFalse,
+		"SpecAccepted", "Pulsetic accepted the monitor settings",
+	)
+	setMonitorCondition(monitor, pulseticv1.ConditionAccountResolved, metav1.ConditionTrue,
+		"AccountResolved", "Using account "+strconv.Quote(account.Name),
+	)
+	setMonitorCondition(monitor, pulseticv1.ConditionSynced, metav1.ConditionTrue, syncReason, syncMessage)
+	setMonitorCondition(monitor, pulseticv1.ConditionReady, metav1.ConditionTrue, syncReason, syncMessage)
+	setSSLCertificateStatus(r.Recorder, monitor, psmonitor)
+	setLighthouseStatus(r.Recorder, monitor, psmonitor)
+	setHealthConditions(monitor, psmonitor)
 	if err := r.Status().Update(ctx, monitor); err != nil {
 		r.Recorder.Event(monitor, "Warning", "UpdateStatusFailed", err.Error())
 		return ctrl.Result{}, err
@@ -157,6 +254,125 @@ func (r *MonitorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	return ctrl.Result{RequeueAfter: monitor.Spec.Interval.Duration}, nil
 }
 
+// syncRunning pauses or resumes the Pulsetic monitor to match the spec.
+func (r *MonitorReconciler) syncRunning(
+	ctx context.Context,
+	c pulsetic.Client,
+	monitor *pulseticv1.Monitor,
+	psmonitor *pulsetic.Monitor,
+) error {
+	paused := monitor.Paused()
+	if psmonitor.IsRunning != paused {
+		return nil
+	}
+
+	if paused {
+		if err := c.Monitors().Pause(ctx, psmonitor.ID); err != nil {
+			return err
+		}
+		r.Recorder.Event(monitor, "Normal", "MonitorPaused", "Paused checks for monitor "+strconv.Quote(monitor.Name))
+	} else {
+		if err := c.Monitors().Resume(ctx, psmonitor.ID); err != nil {
+			return err
+		}
+		r.Recorder.Event(monitor, "Normal", "MonitorResumed", "Resumed checks for monitor "+strconv.Quote(monitor.Name))
+	}
+	psmonitor.IsRunning = !paused
+	return nil
+}
+
+// checkDrift compares the desired settings to the Pulsetic monitor, updates the Drifted condition,
+// and returns true if the monitor should be updated.
+func (r *MonitorReconciler) checkDrift(monitor *pulseticv1.Monitor, desiredHash string, diff []string) bool {
+	if monitor.Spec.DriftPolicy == pulseticv1.DriftPolicyIgnore {
+		meta.RemoveStatusCondition(&monitor.Status.Conditions, pulseticv1.ConditionDrifted)
+		return len(diff) != 0 && desiredHash != monitor.Status.LastAppliedHash
+	}
+
+	if len(diff) == 0 {
+		setMonitorCondition(monitor, pulseticv1.ConditionDrifted, metav1.ConditionFalse,
+			"NoDrift", "Monitor matches the spec",
+		)
+		return false
+	}
+
+	if desiredHash != monitor.Status.LastAppliedHash {
+		// The desired settings changed since the last update, so a difference is expected.
+		setMonitorCondition(monitor, pulseticv1.ConditionDrifted, metav1.ConditionFalse,
+			"NoDrift", "Monitor matches the spec",
+		)
+		return true
+	}
+
+	msg := "Monitor was changed outside of the operator: " + strings.Join(diff, ", ")
+	if monitor.Spec.DriftPolicy == pulseticv1.DriftPolicyReport {
+		r.Recorder.Event(monitor, "Warning", "DriftDetected", msg)
+		setMonitorCondition(monitor, pulseticv1.ConditionDrifted, metav1.ConditionTrue, "DriftDetected", msg)
+		return false
+	}
+
+	r.Recorder.Event(monitor, "Warning", "DriftCorrected", msg)
+	setMonitorCondition(monitor, pulseticv1.ConditionDrifted, metav1.ConditionFalse, "DriftCorrected", msg)
+	return true
+}
+
+// conflict records a Warning event and marks the Monitor as conflicting with another resource.
+// The Pulsetic monitor is left untouched, and reconciliation is retried after the interval.
+func (r *MonitorReconciler) conflict(
+	ctx context.Context,
+	monitor *pulseticv1.Monitor,
+	reason, message string,
+) (ctrl.Result, error) {
+	r.Recorder.Event(monitor, "Warning", reason, message)
+
+	monitor.Status.ObservedGeneration = monitor.Generation
+	setMonitorCondition(monitor, pulseticv1.ConditionConflict, metav1.ConditionTrue, reason, message)
+	setMonitorCondition(monitor, pulseticv1.ConditionReady, metav1.ConditionFalse, reason, message)
+	if err := r.Status().Update(ctx, monitor); err != nil {
+		r.Recorder.Event(monitor, "Warning", "UpdateStatusFailed", err.Error())
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: monitor.Spec.Interval.Duration}, nil
+}
+
+// invalid records a Warning event and marks the Monitor as rejected by Pulsetic.
+// No error is returned and no requeue is scheduled, so the Monitor is not retried until it or its inputs change.
+func (r *MonitorReconciler) invalid(
+	ctx context.Context,
+	monitor *pulseticv1.Monitor,
+	message string,
+) (ctrl.Result, error) {
+	r.Recorder.Event(monitor, "Warning", "InvalidSpec", message)
+
+	monitor.Status.ObservedGeneration = monitor.Generation
+	setMonitorCondition(monitor, pulseticv1.ConditionInvalidSpec, metav1.ConditionTrue, "ValidationFailed", message)
+	setMonitorCondition(monitor, pulseticv1.ConditionSynced, metav1.ConditionFalse, "InvalidSpec", message)
+	setMonitorCondition(monitor, pulseticv1.ConditionReady, metav1.ConditionFalse, "InvalidSpec", message)
+	if err := r.Status().Update(ctx, monitor); err != nil {
+		r.Recorder.Event(monitor, "Warning", "UpdateStatusFailed", err.Error())
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// fail records a Warning event, marks the given condition and Ready as false, and returns err.
+func (r *MonitorReconciler) fail(
+	ctx context.Context,
+	monitor *pulseticv1.Monitor,
+	conditionType, reason string,
+	err error,
+) (ctrl.Result, error) {
+	r.Recorder.Event(monitor, "Warning", reason, err.Error())
+
+	monitor.Status.ObservedGeneration = monitor.Generation
+	setMonitorCondition(monitor, conditionType, metav1.ConditionFalse, reason, err.Error())
+	setMonitorCondition(monitor, pulseticv1.ConditionReady, metav1.ConditionFalse, reason, err.Error())
+	if err := r.Status().Update(ctx, monitor); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update status")
+	}
+	return ctrl.Result{}, err
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *MonitorReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &pulseticv1.Monitor{}, "status.sourceRef", func(rawObj client.Object) []string {
@@ -169,20 +385,168 @@ func (r *MonitorReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return err
 	}
 
+	if err := mgr.GetFieldIndexer().IndexField(
+		context.Background(), &pulseticv1.Monitor{}, secretRefsField, indexMonitorSecretRefs,
+	); err != nil {
+		return err
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(
+		context.Background(), &pulseticv1.Monitor{}, notificationsField, indexMonitorNotifications,
+	); err != nil {
+		return err
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &pulseticv1.Monitor{}, statusIDField, func(rawObj client.Object) []string {
+		monitor := rawObj.(*pulseticv1.Monitor) //nolint:errcheck
+		if monitor.Status.ID == 0 {
+			return nil
+		}
+		return []string{strconv.FormatInt(monitor.Status.ID, 10)}
+	}); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&pulseticv1.Monitor{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.findMonitorsForSecret),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		Watches(&pulseticv1.Contact{}, handler.EnqueueRequestsFromMapFunc(r.findMonitorsForContact),
+			builder.WithPredicates(contactChangedPredicate()),
+		).
+		Watches(&pulseticv1.AccountBinding{}, handler.EnqueueRequestsFromMapFunc(r.findMonitorsForAccountBinding)).
 		Named("monitor").
 		Complete(r)
 }
 
-func tryFindMonitor(ctx context.Context, c pulsetic.Client, id int64, url string) (pulsetic.Monitor, error) {
-	if id != 0 {
-		if psmonitor, err := c.Monitors().Get(ctx, pulsetic.FindByID(id)); err == nil {
+// findMonitorsForAccountBinding enqueues the Monitors in the binding's namespace that use its Account,
+// so that changed namespace defaults are applied.
+func (r *MonitorReconciler) findMonitorsForAccountBinding(ctx context.Context, obj client.Object) []reconcile.Request {
+	binding := obj.(*pulseticv1.AccountBinding) //nolint:errcheck
+
+	list := &pulseticv1.MonitorList{}
+	if err := r.List(ctx, list, client.InNamespace(binding.Namespace)); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, monitor := range list.Items {
+		if monitor.Spec.Account.Name == "" || monitor.Spec.Account.Name == binding.Spec.AccountRef.Name {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&monitor)})
+		}
+	}
+	return requests
+}
+
+// findMonitor finds the Pulsetic monitor for this resource according to its adoption policy.
+// Lookups by URL skip monitors owned by another resource, so only an explicit or previously
+// recorded ID can find a monitor that conflicts with this one.
+func (r *MonitorReconciler) findMonitor(
+	ctx context.Context,
+	c pulsetic.Client,
+	monitor *pulseticv1.Monitor,
+) (pulsetic.Monitor, error) {
+	// An explicit ID takes precedence over the one in the status,
+	// so that changing it switches the Pulsetic monitor being managed.
+	if id := monitor.Spec.Monitor.ID; id != 0 {
+		return c.Monitors().FindByID(ctx, id)
+	}
+
+	if id := monitor.Status.ID; id != 0 {
+		if psmonitor, err := c.Monitors().FindByID(ctx, id); err == nil {
 			return psmonitor, nil
 		} else if !errors.Is(err, pulsetic.ErrMonitorNotFound) {
 			return pulsetic.Monitor{}, err
 		}
 	}
 
-	return c.Monitors().Get(ctx, pulsetic.FindByURL(url))
+	if monitor.Spec.Adopt == pulseticv1.AdoptPolicyNever {
+		return pulsetic.Monitor{}, pulsetic.ErrMonitorNotFound
+	}
+
+	for psmonitor, err := range c.Monitors().List(ctx) {
+		if err != nil {
+			return pulsetic.Monitor{}, err
+		}
+		if !psmonitor.MatchesURL(monitor.Spec.Monitor.URL) {
+			continue
+		}
+		// Monitors owned by another resource are never adopted by URL, so a new one is created instead.
+		if _, owned := ownedByOther(psmonitor.Name, monitor); owned {
+			continue
+		}
+		return psmonitor, nil
+	}
+	return pulsetic.Monitor{}, pulsetic.ErrMonitorNotFound
+}
+
+// deleteMonitor deletes the Pulsetic monitor unless it is owned by a different Monitor resource.
+func (r *MonitorReconciler) deleteMonitor(ctx context.Context, c pulsetic.Client, monitor *pulseticv1.Monitor) error {
+	start := time.Now()
+
+	psmonitor, err := c.Monitors().FindByID(ctx, monitor.Status.ID)
+	if err != nil {
+		if errors.Is(err, pulsetic.ErrMonitorNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if owner, owned := ownedByOther(psmonitor.Name, monitor); owned {
+		r.Recorder.Event(monitor, "Warning", "MonitorOwnedByOther",
+			"Not deleting Pulsetic monitor "+strconv.FormatInt(psmonitor.ID, 10)+" owned by "+owner.String(),
+		)
+		return nil
+	}
+
+	if err := c.Monitors().Delete(ctx, psmonitor.ID); err != nil {
+		return err
+	}
+
+	r.Recorder.Event(monitor, "Normal", "DeleteMonitorSucceeded",
+		"Deleted monitor "+strconv.Quote(monitor.Name)+" in "+time.Since(start).String(),
+	)
+	return nil
+}
+
+// releaseMonitor removes this resource's owner marker from the Pulsetic monitor, leaving the monitor in place.
+// Released monitors are skipped by garbage collection and can be adopted by another Monitor resource.
+func (r *MonitorReconciler) releaseMonitor(ctx context.Context, c pulsetic.Client, monitor *pulseticv1.Monitor) error {
+	psmonitor, err := c.Monitors().FindByID(ctx, monitor.Status.ID)
+	if err != nil {
+		if errors.Is(err, pulsetic.ErrMonitorNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if owner, _, ok := parseOwnerMarker(psmonitor.Name); !ok || owner != ownerOf(monitor) {
+		return nil
+	}
+
+	psmonitor.Name = stripOwnerMarker(psmonitor.Name)
+	if _, err := c.Monitors().Update(ctx, psmonitor.ID, psmonitor); err != nil {
+		return err
+	}
+
+	r.Recorder.Event(monitor, "Normal", "ReleaseMonitorSucceeded",
+		"Released Pulsetic monitor "+strconv.FormatInt(psmonitor.ID, 10)+" without deleting it",
+	)
+	return nil
+}
+
+// findOwner returns the namespaced name of another Monitor that already manages the given Pulsetic monitor.
+func (r *MonitorReconciler) findOwner(ctx context.Context, monitor *pulseticv1.Monitor, id int64) (string, error) {
+	list := &pulseticv1.MonitorList{}
+	if err := r.List(ctx, list, client.MatchingFields{statusIDField: strconv.FormatInt(id, 10)}); err != nil {
+		return "", err
+	}
+
+	for _, other := range list.Items {
+		if other.UID != monitor.UID {
+			return other.Namespace + "/" + other.Name, nil
+		}
+	}
+	return "", nil
 }
@@ -17,7 +17,20 @@ limitations under the License.
 package controller
 
 import (
+	"testing"
+	"time"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
 	. "github.com/onsi/ginkgo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var _ = Describe("Monitor Controller", func() {
@@ -29,3 +42,181 @@ var _ = Describe("Monitor Controller", func() {
 		})
 	})
 })
+
+func TestMonitorReconciler_findMonitor(t *testing.T) {
+	clusterName := ClusterName
+	ClusterName = "prod"
+	t.Cleanup(func() { ClusterName = clusterName })
+
+	_, psclient := newFakePulsetic(t,
+		pulsetic.Monitor{ID: 1, Name: "One", URL: "https://one.example.com"},
+		pulsetic.Monitor{ID: 2, Name: "Two", URL: "https://two.example.com"},
+		pulsetic.Monitor{ID: 4, Name: "Shared [k8s:staging/default/example]", URL: "https://shared.example.com"},
+		pulsetic.Monitor{ID: 5, Name: "Shared", URL: "https://shared.example.com/"},
+		pulsetic.Monitor{ID: 6, Name: "Other [k8s:staging/default/example]", URL: "https://other.example.com"},
+	)
+
+	tests := []struct {
+		name     string
+		statusID int64
+		specID   int64
+		url      string
+		adopt    pulseticv1.AdoptPolicy
+		wantID   int64
+		wantErr  error
+	}{
+		{"status id", 1, 0, "https://two.example.com", pulseticv1.AdoptPolicyIfExists, 1, nil},
+		{"spec id takes precedence", 1, 2, "", pulseticv1.AdoptPolicyIfExists, 2, nil},
+		{"spec id not found", 1, 3, "", pulseticv1.AdoptPolicyIfExists, 0, pulsetic.ErrMonitorNotFound},
+		{"status id not found falls back to url", 3, 0, "https://two.example.com", pulseticv1.AdoptPolicyIfExists, 2, nil},
+		{"url", 0, 0, "https://two.example.com", pulseticv1.AdoptPolicyIfExists, 2, nil},
+		{"url with adopt never", 0, 0, "https://two.example.com", pulseticv1.AdoptPolicyNever, 0, pulsetic.ErrMonitorNotFound},
+		{"url not found", 0, 0, "https://three.example.com", pulseticv1.AdoptPolicyIfExists, 0, pulsetic.ErrMonitorNotFound},
+		{"url skips owned by other", 0, 0, "https://shared.example.com/", pulseticv1.AdoptPolicyIfExists, 5, nil},
+		{"url only owned by other", 0, 0, "https://other.example.com", pulseticv1.AdoptPolicyIfExists, 0, pulsetic.ErrMonitorNotFound},
+		{"spec id owned by other", 0, 6, "", pulseticv1.AdoptPolicyIfExists, 6, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			monitor := &pulseticv1.Monitor{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "example"},
+				Spec: pulseticv1.MonitorSpec{
+					Adopt:   tt.adopt,
+					Monitor: pulseticv1.MonitorValues{ID: tt.specID, URL: tt.url},
+				},
+				Status: pulseticv1.MonitorStatus{ID: tt.statusID},
+			}
+
+			got, err := (&MonitorReconciler{}).findMonitor(t.Context(), psclient, monitor)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, got.ID)
+		})
+	}
+}
+
+func TestMonitorReconciler_releaseMonitor(t *testing.T) {
+	clusterName := ClusterName
+	ClusterName = "prod"
+	t.Cleanup(func() { ClusterName = clusterName })
+
+	tests := []struct {
+		name     string
+		remote   string
+		statusID int64
+		want     string
+	}{
+		{"own marker removed", "Example [k8s-keep:prod/default/example]", 1, "Example"},
+		{"other owner unchanged", "Example [k8s:prod/default/other]", 1, "Example [k8s:prod/default/other]"},
+		{"no marker unchanged", "Example", 1, "Example"},
+		{"not found", "Example [k8s-keep:prod/default/example]", 2, "Example [k8s-keep:prod/default/example]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, psclient := newFakePulsetic(t, pulsetic.Monitor{ID: 1, Name: tt.remote, URL: "https://example.com"})
+			monitor := &pulseticv1.Monitor{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "example"},
+				Status:     pulseticv1.MonitorStatus{ID: tt.statusID},
+			}
+
+			r := &MonitorReconciler{Recorder: record.NewFakeRecorder(10)}
+			require.NoError(t, r.releaseMonitor(t.Context(), psclient, monitor))
+			got, ok := fake.Monitor(1)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, got.Name)
+			assert.Equal(t, "https://example.com", got.URL)
+		})
+	}
+}
+
+func TestMonitorReconciler_syncRunning(t *testing.T) {
+	tests := []struct {
+		name        string
+		running     bool
+		suspend     bool
+		wantRunning bool
+		wantActions []string
+	}{
+		{"running", true, false, true, nil},
+		{"paused", false, true, false, nil},
+		{"pause", true, true, false, []string{"1/stop"}},
+		{"resume", false, false, true, []string{"1/start"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			psmonitor := pulsetic.Monitor{ID: 1, IsRunning: tt.running}
+			fake, psclient := newFakePulsetic(t, psmonitor)
+			monitor := &pulseticv1.Monitor{Spec: pulseticv1.MonitorSpec{Suspend: tt.suspend}}
+
+			r := &MonitorReconciler{Recorder: record.NewFakeRecorder(10)}
+			require.NoError(t, r.syncRunning(t.Context(), psclient, monitor, &psmonitor))
+			assert.Equal(t, tt.wantRunning, psmonitor.IsRunning)
+			assert.Equal(t, tt.wantActions, fake.Actions())
+		})
+	}
+}
+
+func TestMonitorReconciler_Reconcile_unsupportedValue(t *testing.T) {
+	_, _ = newFakePulsetic(t)
+	account, secret := newTestAccount(t, "example")
+	monitor := &pulseticv1.Monitor{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "example", Generation: 1},
+		Spec: pulseticv1.MonitorSpec{
+			Account:                corev1.LocalObjectReference{Name: "example"},
+			UnsupportedValuePolicy: pulseticv1.UnsupportedValuePolicyReject,
+			Monitor: pulseticv1.MonitorValues{
+				URL: "https://example.com",
+				MonitorDefaults: pulseticv1.MonitorDefaults{
+					Interval: &metav1.Duration{Duration: 7 * time.Minute},
+				},
+			},
+		},
+	}
+	c := fakeClientBuilder(t, account, secret, monitor).WithStatusSubresource(monitor).Build()
+	r := &MonitorReconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+
+	// A value that can never be synced is reported without retrying.
+	res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(monitor)})
+	require.NoError(t, err)
+	assert.Zero(t, res)
+
+	got := &pulseticv1.Monitor{}
+	require.NoError(t, c.Get(t.Context(), client.ObjectKeyFromObject(monitor), got))
+	assert.True(t, meta.IsStatusConditionTrue(got.Status.Conditions, pulseticv1.ConditionInvalidSpec))
+	invalid := meta.FindStatusCondition(got.Status.Conditions, pulseticv1.ConditionInvalidSpec)
+	assert.Contains(t, invalid.Message, ErrUnsupportedValue.Error())
+	assert.False(t, meta.IsStatusConditionTrue(got.Status.Conditions, pulseticv1.ConditionReady))
+}
+
+func TestMonitorReconciler_Reconcile_unknownRegion(t *testing.T) {
+	_, _ = newFakePulsetic(t)
+	account, secret := newTestAccount(t, "example")
+	monitor := &pulseticv1.Monitor{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "example", Generation: 1},
+		Spec: pulseticv1.MonitorSpec{
+			Account: corev1.LocalObjectReference{Name: "example"},
+			Monitor: pulseticv1.MonitorValues{
+				URL:     "https://example.com",
+				Regions: []string{"us-east", "mars"},
+			},
+		},
+	}
+	c := fakeClientBuilder(t, account, secret, monitor).WithStatusSubresource(monitor).Build()
+	r := &MonitorReconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+
+	// A region that no node matches is reported without retrying.
+	res, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(monitor)})
+	require.NoError(t, err)
+	assert.Zero(t, res)
+
+	got := &pulseticv1.Monitor{}
+	require.NoError(t, c.Get(t.Context(), client.ObjectKeyFromObject(monitor), got))
+	invalid := meta.FindStatusCondition(got.Status.Conditions, pulseticv1.ConditionInvalidSpec)
+	require.NotNil(t, invalid)
+	assert.Equal(t, metav1.ConditionTrue, invalid.Status)
+	assert.Contains(t, invalid.Message, `unknown region "mars"`)
+	assert.Zero(t, got.Status.ID)
+}
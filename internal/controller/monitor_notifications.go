@@ -0,0 +1,125 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const notificationsField = "spec.monitor.notifications"
+
+var (
+	ErrContactNotReady        = errors.New("contact has not been created in Pulsetic")
+	ErrContactAccountMismatch = errors.New("contact belongs to a different account")
+)
+
+// resolveContacts returns the Pulsetic IDs of the Contacts a Monitor notifies.
+// Every Contact must use the same Account as the Monitor.
+func resolveContacts(
+	ctx context.Context,
+	c client.Client,
+	monitor *pulseticv1.Monitor,
+	accountName string,
+) ([]int64, error) {
+	if len(monitor.Spec.Monitor.Notifications) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]int64, 0, len(monitor.Spec.Monitor.Notifications))
+	accounts := make(map[string]string)
+	for _, ref := range monitor.Spec.Monitor.Notifications {
+		contact := &pulseticv1.Contact{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: monitor.Namespace, Name: ref.Name}, contact); err != nil {
+			return nil, fmt.Errorf("contact %s: %w", ref.Name, err)
+		}
+
+		name, ok := accounts[contact.Spec.Account.Name]
+		if !ok {
+			account := &pulseticv1.Account{}
+			if err := GetAccount(ctx, c, account, contact.Namespace, contact.Spec.Account.Name); err != nil {
+				return nil, fmt.Errorf("contact %s: %w", ref.Name, err)
+			}
+			name = account.Name
+			accounts[contact.Spec.Account.Name] = name
+		}
+		if name != accountName {
+			return nil, fmt.Errorf("contact %s: %w: uses %q instead of %q",
+				ref.Name, ErrContactAccountMismatch, name, accountName,
+			)
+		}
+
+		if contact.Status.ID == 0 {
+			return nil, fmt.Errorf("contact %s: %w", ref.Name, ErrContactNotReady)
+		}
+		ids = append(ids, contact.Status.ID)
+	}
+	return ids, nil
+}
+
+func indexMonitorNotifications(rawObj client.Object) []string {
+	monitor := rawObj.(*pulseticv1.Monitor) //nolint:errcheck
+	names := make([]string, 0, len(monitor.Spec.Monitor.Notifications))
+	for _, ref := range monitor.Spec.Monitor.Notifications {
+		names = append(names, ref.Name)
+	}
+	return names
+}
+
+func (r *MonitorReconciler) findMonitorsForContact(ctx context.Context, contact client.Object) []reconcile.Request {
+	list := &pulseticv1.MonitorList{}
+	if err := r.List(ctx, list,
+		client.InNamespace(contact.GetNamespace()),
+		client.MatchingFields{notificationsField: contact.GetName()},
+	); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, monitor := range list.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&monitor)})
+	}
+	return requests
+}
+
+// contactChangedPredicate filters Contact events down to the ones Monitors depend on:
+// spec changes and the Pulsetic ID being set. Other status writes are ignored.
+func contactChangedPredicate() predicate.Predicate {
+	return predicate.Or(
+		predicate.GenerationChangedPredicate{},
+		predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				oldContact, ok := e.ObjectOld.(*pulseticv1.Contact)
+				if !ok {
+					return false
+				}
+				newContact, ok := e.ObjectNew.(*pulseticv1.Contact)
+				if !ok {
+					return false
+				}
+				return oldContact.Status.ID != newContact.Status.ID
+			},
+		},
+	)
+}
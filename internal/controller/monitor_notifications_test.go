@@ -0,0 +1,130 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func newTestContact(name, account string, id int64) *pulseticv1.Contact {
+	return &pulseticv1.Contact{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		Spec:       pulseticv1.ContactSpec{Account: corev1.LocalObjectReference{Name: account}},
+		Status:     pulseticv1.ContactStatus{ID: id},
+	}
+}
+
+func newNotifyingMonitor(name string, contacts ...string) *pulseticv1.Monitor {
+	monitor := &pulseticv1.Monitor{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name}}
+	for _, contact := range contacts {
+		monitor.Spec.Monitor.Notifications = append(monitor.Spec.Monitor.Notifications,
+			pulseticv1.ContactReference{Name: contact},
+		)
+	}
+	return monitor
+}
+
+func Test_resolveContacts(t *testing.T) {
+	example, _ := newTestAccount(t, "example")
+	other, _ := newTestAccount(t, "other")
+	c := fakeClientBuilder(t,
+		example,
+		other,
+		newTestContact("ops", "example", 1),
+		newTestContact("oncall", "example", 2),
+		newTestContact("pending", "example", 0),
+		newTestContact("foreign", "other", 3),
+	).Build()
+
+	tests := []struct {
+		name     string
+		contacts []string
+		want     []int64
+		wantErr  error
+	}{
+		{"none", nil, nil, nil},
+		{"ready", []string{"ops", "oncall"}, []int64{1, 2}, nil},
+		{"not ready", []string{"ops", "pending"}, nil, ErrContactNotReady},
+		{"other account", []string{"ops", "foreign"}, nil, ErrContactAccountMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveContacts(t.Context(), c, newNotifyingMonitor("example", tt.contacts...), "example")
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("missing", func(t *testing.T) {
+		_, err := resolveContacts(t.Context(), c, newNotifyingMonitor("example", "missing"), "example")
+		require.Error(t, err)
+	})
+}
+
+func TestMonitorReconciler_findMonitorsForContact(t *testing.T) {
+	c := fakeClientBuilder(t,
+		newNotifyingMonitor("api", "ops"),
+		newNotifyingMonitor("www", "ops", "oncall"),
+		newNotifyingMonitor("docs", "oncall"),
+	).
+		WithIndex(&pulseticv1.Monitor{}, notificationsField, indexMonitorNotifications).
+		Build()
+	r := &MonitorReconciler{Client: c}
+
+	got := r.findMonitorsForContact(t.Context(), newTestContact("ops", "example", 1))
+	assert.ElementsMatch(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: "api"}},
+		{NamespacedName: types.NamespacedName{Namespace: "default", Name: "www"}},
+	}, got)
+
+	assert.Empty(t, r.findMonitorsForContact(t.Context(), newTestContact("unused", "example", 1)))
+}
+
+func Test_contactChangedPredicate(t *testing.T) {
+	p := contactChangedPredicate()
+
+	base := newTestContact("ops", "example", 0)
+	base.Generation = 1
+
+	statusOnly := base.DeepCopy()
+	statusOnly.Status.Conditions = []metav1.Condition{{Type: pulseticv1.ConditionReady, Status: metav1.ConditionTrue}}
+	assert.False(t, p.Update(event.UpdateEvent{ObjectOld: base, ObjectNew: statusOnly}))
+
+	created := base.DeepCopy()
+	created.Status.ID = 1
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: base, ObjectNew: created}))
+
+	specChanged := base.DeepCopy()
+	specChanged.Generation = 2
+	assert.True(t, p.Update(event.UpdateEvent{ObjectOld: base, ObjectNew: specChanged}))
+
+	assert.True(t, p.Create(event.CreateEvent{Object: base}))
+	assert.True(t, p.Delete(event.DeleteEvent{Object: base}))
+}
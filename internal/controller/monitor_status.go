@@ -0,0 +1,323 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// setCheckStatus copies the latest check results into the status.
+func setCheckStatus(monitor *pulseticv1.Monitor, psmonitor pulsetic.Monitor) {
+	monitor.Status.ID = psmonitor.ID
+	monitor.Status.Running = psmonitor.IsRunning
+	monitor.Status.Replicas = 0
+	if psmonitor.IsRunning {
+		monitor.Status.Replicas = 1
+	}
+	monitor.Status.State = psmonitor.Status
+	monitor.Status.Uptime = strconv.FormatFloat(psmonitor.Uptime, 'f', 2, 64)
+	monitor.Status.ResponseTime = millisecondsToDuration(psmonitor.ResponseTime)
+	monitor.Status.LastCheckedAt = timeOrNil(time.Time(psmonitor.CheckedAt))
+	monitor.Status.StatusChangedAt = timeOrNil(parseTime(psmonitor.StatusChangedAt))
+
+	monitor.Status.Nodes = make([]pulseticv1.NodeStatus, 0, len(psmonitor.Nodes))
+	for _, node := range psmonitor.Nodes {
+		monitor.Status.Nodes = append(monitor.Status.Nodes, pulseticv1.NodeStatus{
+			Title:        node.Title,
+			Location:     node.Location,
+			Active:       node.Active,
+			State:        node.Status,
+			Uptime:       int32(node.Uptime), //nolint:gosec
+			ResponseTime: millisecondsToDuration(float64(node.AverageResponseTime)),
+		})
+	}
+}
+
+func millisecondsToDuration(ms float64) *metav1.Duration {
+	if ms == 0 {
+		return nil
+	}
+	return &metav1.Duration{Duration: time.Duration(ms * float64(time.Millisecond)).Round(time.Millisecond)}
+}
+
+func timeOrNil(t time.Time) *metav1.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &metav1.Time{Time: t}
+}
+
+// parseTime parses the timestamp formats returned by Pulsetic, returning a zero time if none match.
+func parseTime(s string) time.Time {
+	for _, layout := range []string{time.RFC3339Nano, time.DateTime} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// setSSLCertificateStatus copies the monitor's SSL certificate into the status
+// and updates the CertificateExpiring condition.
+func setSSLCertificateStatus(recorder record.EventRecorder, monitor *pulseticv1.Monitor, psmonitor pulsetic.Monitor) {
+	cert := psmonitor.SSLCertificate
+	expiresAt := time.Time(cert.ExpiresAt)
+	if psmonitor.SSLCheck == nil || !bool(*psmonitor.SSLCheck) || expiresAt.IsZero() {
+		monitor.Status.SSLCertificate = nil
+		meta.RemoveStatusCondition(&monitor.Status.Conditions, pulseticv1.ConditionCertificateExpiring)
+		return
+	}
+
+	monitor.Status.SSLCertificate = &pulseticv1.SSLCertificateStatus{
+		Domain:    cert.Domain,
+		Issuer:    cert.IssuedBy,
+		Valid:     bool(cert.IsValid),
+		ExpiresAt: &metav1.Time{Time: expiresAt},
+	}
+
+	condition := metav1.Condition{
+		Type:               pulseticv1.ConditionCertificateExpiring,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: monitor.Generation,
+		Reason:             "CertificateValid",
+		Message:            "Certificate expires " + expiresAt.Format(time.RFC3339),
+	}
+
+	remaining := time.Until(expiresAt)
+	warnAfter := time.Duration(monitor.Spec.SSLExpiryWarningDays) * 24 * time.Hour
+	switch {
+	case !monitor.Status.SSLCertificate.Valid:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "CertificateInvalid"
+		condition.Message = "Certificate for " + strconv.Quote(cert.Domain) + " is invalid"
+	case warnAfter != 0 && remaining < warnAfter:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "CertificateExpiring"
+		// The expiry date keeps the message stable, so the condition doesn't change every day.
+		condition.Message = "Certificate for " + strconv.Quote(cert.Domain) + " expires " +
+			expiresAt.Format(time.RFC3339)
+	}
+
+	// Only warn when the certificate enters a new state, not when the message changes.
+	var previous metav1.Condition
+	if c := meta.FindStatusCondition(monitor.Status.Conditions, condition.Type); c != nil {
+		previous = *c
+	}
+	meta.SetStatusCondition(&monitor.Status.Conditions, condition)
+	if condition.Status == metav1.ConditionTrue &&
+		(previous.Status != condition.Status || previous.Reason != condition.Reason) {
+		recorder.Event(monitor, "Warning", condition.Reason, condition.Message)
+	}
+}
+
+// setLighthouseStatus copies the monitor's Lighthouse scores into the status
+// and updates the LighthouseBelowThreshold condition.
+func setLighthouseStatus(recorder record.EventRecorder, monitor *pulseticv1.Monitor, psmonitor pulsetic.Monitor) {
+	if psmonitor.LighthouseAuditEnabled == nil || !bool(*psmonitor.LighthouseAuditEnabled) {
+		monitor.Status.Lighthouse = nil
+		meta.RemoveStatusCondition(&monitor.Status.Conditions, pulseticv1.ConditionLighthouseBelowThreshold)
+		return
+	}
+
+	monitor.Status.Lighthouse = &pulseticv1.LighthouseStatus{
+		Mobile: pulseticv1.LighthouseScores{
+			Performance:   parseScore(psmonitor.MobilePerformanceScore),
+			Accessibility: parseScore(psmonitor.MobileAccessibilityScore),
+			BestPractices: parseScore(psmonitor.MobileBestPracticesScore),
+			SEO:           parseScore(psmonitor.MobileSEOScore),
+			PWA:           parseScore(psmonitor.MobilePWAScore),
+		},
+		Desktop: pulseticv1.LighthouseScores{
+			Performance:   parseScore(psmonitor.DesktopPerformanceScore),
+			Accessibility: parseScore(psmonitor.DesktopAccessibilityScore),
+			BestPractices: parseScore(psmonitor.DesktopBestPracticesScore),
+			SEO:           parseScore(psmonitor.DesktopSEOScore),
+			PWA:           parseScore(psmonitor.DesktopPWAScore),
+		},
+	}
+
+	var minScores *pulseticv1.LighthouseScores
+	if lighthouse := monitor.Spec.Monitor.Lighthouse; lighthouse != nil {
+		minScores = lighthouse.MinScores
+	}
+	if minScores == nil {
+		meta.RemoveStatusCondition(&monitor.Status.Conditions, pulseticv1.ConditionLighthouseBelowThreshold)
+		return
+	}
+
+	failures := slices.Concat(
+		scoresBelow("mobile", monitor.Status.Lighthouse.Mobile, *minScores),
+		scoresBelow("desktop", monitor.Status.Lighthouse.Desktop, *minScores),
+	)
+
+	condition := metav1.Condition{
+		Type:               pulseticv1.ConditionLighthouseBelowThreshold,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: monitor.Generation,
+		Reason:             "ScoresMeetThreshold",
+		Message:            "All Lighthouse scores meet their minimum",
+	}
+	if len(failures) != 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "ScoreBelowThreshold"
+		condition.Message = "Lighthouse scores below minimum: " + strings.Join(failures, ", ")
+	}
+
+	if changed := meta.SetStatusCondition(&monitor.Status.Conditions, condition); changed &&
+		condition.Status == metav1.ConditionTrue {
+		recorder.Event(monitor, "Warning", condition.Reason, condition.Message)
+	}
+}
+
+// scoresBelow describes each score that is lower than its minimum.
+// Scores that have not been reported yet are ignored.
+func scoresBelow(device string, scores, minScores pulseticv1.LighthouseScores) []string {
+	categories := []struct {
+		name       string
+		score, min *int32
+	}{
+		{"performance", scores.Performance, minScores.Performance},
+		{"accessibility", scores.Accessibility, minScores.Accessibility},
+		{"best practices", scores.BestPractices, minScores.BestPractices},
+		{"SEO", scores.SEO, minScores.SEO},
+		{"PWA", scores.PWA, minScores.PWA},
+	}
+
+	var failures []string
+	for _, c := range categories {
+		if c.score != nil && c.min != nil && *c.score < *c.min {
+			failures = append(failures,
+				device+" "+c.name+" "+strconv.Itoa(int(*c.score))+" < "+strconv.Itoa(int(*c.min)),
+			)
+		}
+	}
+	return failures
+}
+
+// parseScore parses a Lighthouse score reported as either a fraction or a percentage.
+func parseScore(s string) *int32 {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil
+	}
+	if f <= 1 {
+		f *= 100
+	}
+	score := int32(math.Round(f))
+	return &score
+}
+
+// setHealthConditions updates the Up and Degraded conditions from the monitor's last check.
+func setHealthConditions(monitor *pulseticv1.Monitor, psmonitor pulsetic.Monitor) {
+	switch {
+	case !psmonitor.IsRunning:
+		setMonitorCondition(monitor, pulseticv1.ConditionUp, metav1.ConditionUnknown,
+			"MonitorPaused", "Monitor is not running",
+		)
+	case strings.EqualFold(psmonitor.Status, pulsetic.StatusOnline):
+		setMonitorCondition(monitor, pulseticv1.ConditionUp, metav1.ConditionTrue,
+			"MonitorOnline", "Monitor is online",
+		)
+	case strings.EqualFold(psmonitor.Status, pulsetic.StatusOffline):
+		setMonitorCondition(monitor, pulseticv1.ConditionUp, metav1.ConditionFalse,
+			"MonitorOffline", "Monitor is offline",
+		)
+	default:
+		setMonitorCondition(monitor, pulseticv1.ConditionUp, metav1.ConditionUnknown,
+			"MonitorPending", "Monitor status is "+strconv.Quote(psmonitor.Status),
+		)
+	}
+
+	setRegionCondition(monitor)
+
+	degraded := metav1.Condition{
+		Type:    pulseticv1.ConditionDegraded,
+		Status:  metav1.ConditionFalse,
+		Reason:  "AsExpected",
+		Message: "Monitor has no known problems",
+	}
+	var messages []string
+	for _, conditionType := range []string{
+		pulseticv1.ConditionRegionDown,
+		pulseticv1.ConditionCertificateExpiring,
+		pulseticv1.ConditionLighthouseBelowThreshold,
+	} {
+		if cond := meta.FindStatusCondition(monitor.Status.Conditions, conditionType); cond != nil &&
+			cond.Status == metav1.ConditionTrue {
+			if degraded.Status != metav1.ConditionTrue {
+				degraded.Status = metav1.ConditionTrue
+				degraded.Reason = cond.Reason
+			}
+			messages = append(messages, cond.Message)
+		}
+	}
+	if len(messages) != 0 {
+		degraded.Message = strings.Join(messages, "; ")
+	}
+	setMonitorCondition(monitor, degraded.Type, degraded.Status, degraded.Reason, degraded.Message)
+}
+
+// setRegionCondition sets the RegionDown condition when some of the monitor's check nodes report it as offline.
+func setRegionCondition(monitor *pulseticv1.Monitor) {
+	var total int
+	var offline []string
+	for _, node := range monitor.Status.Nodes {
+		if !node.Active {
+			continue
+		}
+		total++
+		if strings.EqualFold(node.State, pulsetic.StatusOffline) {
+			offline = append(offline, node.Title)
+		}
+	}
+
+	switch {
+	case total == 0:
+		meta.RemoveStatusCondition(&monitor.Status.Conditions, pulseticv1.ConditionRegionDown)
+	case len(offline) != 0 && len(offline) != total:
+		setMonitorCondition(monitor, pulseticv1.ConditionRegionDown, metav1.ConditionTrue,
+			"NodesOffline", "Monitor is offline from "+strings.Join(offline, ", "),
+		)
+	default:
+		setMonitorCondition(monitor, pulseticv1.ConditionRegionDown, metav1.ConditionFalse,
+			"NodesAgree", "All check nodes report the same state",
+		)
+	}
+}
+
+func setMonitorCondition(monitor *pulseticv1.Monitor, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&monitor.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: monitor.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+	if conditionType == pulseticv1.ConditionReady {
+		monitor.Status.Ready = status == metav1.ConditionTrue
+	}
+}
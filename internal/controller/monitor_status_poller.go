@@ -0,0 +1,193 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// MonitorStatusPoller periodically refreshes the status of every Monitor from Pulsetic.
+// Monitors are batched by Account so that each poll issues one list per Account
+// instead of one request per Monitor.
+type MonitorStatusPoller struct {
+	client.Client
+	Recorder record.EventRecorder
+	Interval time.Duration
+}
+
+var _ manager.LeaderElectionRunnable = &MonitorStatusPoller{}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (p *MonitorStatusPoller) NeedLeaderElection() bool {
+	return true
+}
+
+// Start implements manager.Runnable.
+func (p *MonitorStatusPoller) Start(ctx context.Context) error {
+	ticker := time.NewTicker(p.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := p.Poll(ctx); err != nil {
+				log.FromContext(ctx).Error(err, "Failed to poll monitor status")
+			}
+		}
+	}
+}
+
+// Poll refreshes the status of all Monitors once.
+func (p *MonitorStatusPoller) Poll(ctx context.Context) error {
+	accounts := &pulseticv1.AccountList{}
+	if err := p.List(ctx, accounts); err != nil {
+		return err
+	}
+
+	monitors := &pulseticv1.MonitorList{}
+	if err := p.List(ctx, monitors); err != nil {
+		return err
+	}
+
+	// Resolve each namespace/account pair once so that bindings and allowed namespaces are honored
+	// without repeating lookups for every Monitor.
+	resolved := make(map[string]string)
+	byAccount := make(map[string][]*pulseticv1.Monitor, len(accounts.Items))
+	for i := range monitors.Items {
+		monitor := &monitors.Items[i]
+		if monitor.Status.ID == 0 || !monitor.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		key := monitor.Namespace + "/" + monitor.Spec.Account.Name
+		name, ok := resolved[key]
+		if !ok {
+			account := &pulseticv1.Account{}
+			if err := GetAccount(ctx, p.Client, account, monitor.Namespace, monitor.Spec.Account.Name); err != nil {
+				log.FromContext(ctx).Error(err, "Failed to resolve account", "monitor", client.ObjectKeyFromObject(monitor))
+			} else {
+				name = account.Name
+			}
+			resolved[key] = name
+		}
+		if name != "" {
+			byAccount[name] = append(byAccount[name], monitor)
+		}
+	}
+
+	for i := range accounts.Items {
+		account := &accounts.Items[i]
+		if len(byAccount[account.Name]) == 0 {
+			continue
+		}
+
+		if err := p.pollAccount(ctx, account, byAccount[account.Name]); err != nil {
+			p.Recorder.Event(account, "Warning", "PollStatusFailed", err.Error())
+		}
+	}
+	return nil
+}
+
+func (p *MonitorStatusPoller) pollAccount(
+	ctx context.Context,
+	account *pulseticv1.Account,
+	monitors []*pulseticv1.Monitor,
+) error {
+	// Namespaces can bind the Account with their own API key, so monitors are listed once per key.
+	// A failure for one namespace or key doesn't stop the others from being polled.
+	var errs []error
+	keys := make(map[string]string)
+	failed := make(map[string]bool)
+	byKey := make(map[string][]*pulseticv1.Monitor)
+	for _, monitor := range monitors {
+		if failed[monitor.Namespace] {
+			continue
+		}
+		apiKey, ok := keys[monitor.Namespace]
+		if !ok {
+			var err error
+			if apiKey, err = GetAPIKey(ctx, p.Client, account, monitor.Namespace); err != nil {
+				failed[monitor.Namespace] = true
+				errs = append(errs, fmt.Errorf("namespace %s: %w", monitor.Namespace, err))
+				continue
+			}
+			keys[monitor.Namespace] = apiKey
+		}
+		byKey[apiKey] = append(byKey[apiKey], monitor)
+	}
+
+	for apiKey, monitors := range byKey {
+		if err := p.pollMonitors(ctx, pulsetic.NewClient(apiKey), monitors); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (p *MonitorStatusPoller) pollMonitors(
+	ctx context.Context,
+	psclient pulsetic.Client,
+	monitors []*pulseticv1.Monitor,
+) error {
+	remote := make(map[int64]pulsetic.Monitor, len(monitors))
+	for psmonitor, err := range psclient.Monitors().List(ctx) {
+		if err != nil {
+			return err
+		}
+		remote[psmonitor.ID] = psmonitor
+	}
+
+	for _, monitor := range monitors {
+		psmonitor, ok := remote[monitor.Status.ID]
+		if !ok {
+			continue
+		}
+
+		// A merge patch replaces the whole conditions list, so the patch is rejected if a reconcile
+		// updated the Monitor since it was listed. It is skipped and refreshed by the next poll.
+		patch := client.MergeFromWithOptions(monitor.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		setCheckStatus(monitor, psmonitor)
+		setSSLCertificateStatus(p.Recorder, monitor, psmonitor)
+		setLighthouseStatus(p.Recorder, monitor, psmonitor)
+		setHealthConditions(monitor, psmonitor)
+		if err := p.Status().Patch(ctx, monitor, patch); err != nil {
+			if apierrors.IsConflict(err) {
+				log.FromContext(ctx).V(1).Info("Skipping status of monitor changed since it was listed",
+					"monitor", client.ObjectKeyFromObject(monitor),
+				)
+				continue
+			}
+			log.FromContext(ctx).Error(err, "Failed to patch monitor status",
+				"monitor", client.ObjectKeyFromObject(monitor),
+			)
+		}
+	}
+	return nil
+}
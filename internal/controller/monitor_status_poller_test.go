@@ -0,0 +1,115 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestMonitorStatusPoller_pollAccount(t *testing.T) {
+	newFakePulsetic(t, pulsetic.Monitor{ID: 1, IsRunning: true, Status: pulsetic.StatusOnline})
+
+	account, secret := newTestAccount(t, "example")
+	newMonitor := func(name string) *pulseticv1.Monitor {
+		return &pulseticv1.Monitor{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Status:     pulseticv1.MonitorStatus{ID: 1},
+		}
+	}
+	c := fakeClientBuilder(t, account, secret, newMonitor("fresh"), newMonitor("stale")).
+		WithStatusSubresource(&pulseticv1.Monitor{}).
+		Build()
+
+	fresh := &pulseticv1.Monitor{}
+	require.NoError(t, c.Get(t.Context(), client.ObjectKey{Namespace: "default", Name: "fresh"}, fresh))
+
+	// A reconcile updates the Monitor after the poller listed it.
+	stale := &pulseticv1.Monitor{}
+	require.NoError(t, c.Get(t.Context(), client.ObjectKey{Namespace: "default", Name: "stale"}, stale))
+	reconciled := stale.DeepCopy()
+	setMonitorCondition(reconciled, pulseticv1.ConditionInvalidSpec, metav1.ConditionTrue, "ValidationFailed", "invalid")
+	require.NoError(t, c.Status().Update(t.Context(), reconciled))
+
+	p := &MonitorStatusPoller{Client: c, Recorder: record.NewFakeRecorder(10)}
+	require.NoError(t, p.pollAccount(t.Context(), account, []*pulseticv1.Monitor{fresh, stale}))
+
+	got := &pulseticv1.Monitor{}
+	require.NoError(t, c.Get(t.Context(), client.ObjectKeyFromObject(fresh), got))
+	assert.Equal(t, pulsetic.StatusOnline, got.Status.State)
+	assert.True(t, meta.IsStatusConditionTrue(got.Status.Conditions, pulseticv1.ConditionUp))
+
+	require.NoError(t, c.Get(t.Context(), client.ObjectKeyFromObject(stale), got))
+	assert.Empty(t, got.Status.State, "a stale Monitor should be skipped")
+	assert.True(t, meta.IsStatusConditionTrue(got.Status.Conditions, pulseticv1.ConditionInvalidSpec),
+		"the reconciler's conditions should be kept",
+	)
+}
+
+func TestMonitorStatusPoller_pollAccount_partialFailure(t *testing.T) {
+	newFakePulsetic(t, pulsetic.Monitor{ID: 1, IsRunning: true, Status: pulsetic.StatusOnline})
+
+	account, secret := newTestAccount(t, "example")
+	newMonitor := func(namespace string) *pulseticv1.Monitor {
+		return &pulseticv1.Monitor{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "example"},
+			Status:     pulseticv1.MonitorStatus{ID: 1},
+		}
+	}
+	newBinding := func(namespace string) *pulseticv1.AccountBinding {
+		return &pulseticv1.AccountBinding{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "example"},
+			Spec: pulseticv1.AccountBindingSpec{
+				AccountRef: corev1.LocalObjectReference{Name: "example"},
+				APIKeySecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "pulsetic"},
+					Key:                  "apiKey",
+				},
+			},
+		}
+	}
+	monitors := []*pulseticv1.Monitor{newMonitor("missing-secret"), newMonitor("rejected-key"), newMonitor("default")}
+	c := fakeClientBuilder(t, account, secret, monitors[0], monitors[1], monitors[2],
+		newBinding("missing-secret"),
+		newBinding("rejected-key"),
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "rejected-key", Name: "pulsetic"},
+			Data:       map[string][]byte{"apiKey": []byte("invalid")},
+		},
+	).
+		WithStatusSubresource(&pulseticv1.Monitor{}).
+		Build()
+
+	p := &MonitorStatusPoller{Client: c, Recorder: record.NewFakeRecorder(10)}
+	err := p.pollAccount(t.Context(), account, monitors)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "namespace missing-secret")
+	assert.Contains(t, err.Error(), "Unauthenticated")
+
+	got := &pulseticv1.Monitor{}
+	require.NoError(t, c.Get(t.Context(), client.ObjectKeyFromObject(monitors[2]), got))
+	assert.Equal(t, pulsetic.StatusOnline, got.Status.State, "other namespaces are still polled")
+}
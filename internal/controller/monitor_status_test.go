@@ -0,0 +1,229 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+)
+
+func Test_parseScore(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want *int32
+	}{
+		{"empty", "", nil},
+		{"fraction", "0.87", ptr.To[int32](87)},
+		{"percentage", "92", ptr.To[int32](92)},
+		{"perfect fraction", "1", ptr.To[int32](100)},
+		{"zero", "0", ptr.To[int32](0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseScore(tt.s))
+		})
+	}
+}
+
+func Test_scoresBelow(t *testing.T) {
+	scores := pulseticv1.LighthouseScores{
+		Performance:   ptr.To[int32](45),
+		Accessibility: ptr.To[int32](90),
+	}
+	minScores := pulseticv1.LighthouseScores{
+		Performance:   ptr.To[int32](80),
+		Accessibility: ptr.To[int32](90),
+		SEO:           ptr.To[int32](80),
+	}
+	assert.Equal(t, []string{"mobile performance 45 < 80"}, scoresBelow("mobile", scores, minScores))
+}
+
+func Test_setHealthConditions(t *testing.T) {
+	tests := []struct {
+		name         string
+		psmonitor    pulsetic.Monitor
+		conditions   []metav1.Condition
+		wantUp       metav1.ConditionStatus
+		wantUpReason string
+		wantDegraded metav1.ConditionStatus
+	}{
+		{"paused", pulsetic.Monitor{Status: pulsetic.StatusOnline}, nil,
+			metav1.ConditionUnknown, "MonitorPaused", metav1.ConditionFalse},
+		{"online", pulsetic.Monitor{IsRunning: true, Status: "Online"}, nil,
+			metav1.ConditionTrue, "MonitorOnline", metav1.ConditionFalse},
+		{"offline", pulsetic.Monitor{IsRunning: true, Status: pulsetic.StatusOffline}, nil,
+			metav1.ConditionFalse, "MonitorOffline", metav1.ConditionFalse},
+		{"pending", pulsetic.Monitor{IsRunning: true, Status: "pending"}, nil,
+			metav1.ConditionUnknown, "MonitorPending", metav1.ConditionFalse},
+		{
+			"certificate expiring",
+			pulsetic.Monitor{IsRunning: true, Status: pulsetic.StatusOnline},
+			[]metav1.Condition{{
+				Type:    pulseticv1.ConditionCertificateExpiring,
+				Status:  metav1.ConditionTrue,
+				Reason:  "CertificateExpiring",
+				Message: "Certificate expires soon",
+			}},
+			metav1.ConditionTrue, "MonitorOnline", metav1.ConditionTrue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			monitor := &pulseticv1.Monitor{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status:     pulseticv1.MonitorStatus{Conditions: tt.conditions},
+			}
+			setHealthConditions(monitor, tt.psmonitor)
+
+			up := meta.FindStatusCondition(monitor.Status.Conditions, pulseticv1.ConditionUp)
+			require.NotNil(t, up)
+			assert.Equal(t, tt.wantUp, up.Status)
+			assert.Equal(t, tt.wantUpReason, up.Reason)
+			assert.EqualValues(t, 2, up.ObservedGeneration)
+
+			degraded := meta.FindStatusCondition(monitor.Status.Conditions, pulseticv1.ConditionDegraded)
+			require.NotNil(t, degraded)
+			assert.Equal(t, tt.wantDegraded, degraded.Status)
+		})
+	}
+}
+
+func Test_setCheckStatus(t *testing.T) {
+	checkedAt := time.Unix(1700000000, 0)
+	psmonitor := pulsetic.Monitor{
+		ID:              1,
+		IsRunning:       true,
+		Status:          pulsetic.StatusOnline,
+		Uptime:          99.5,
+		ResponseTime:    123.4,
+		CheckedAt:       pulsetic.UnixOrTime(checkedAt),
+		StatusChangedAt: "2025-01-02 03:04:05",
+		Nodes: []pulsetic.Node{{
+			Title:               "Frankfurt",
+			Location:            "eu",
+			Active:              true,
+			Status:              pulsetic.StatusOnline,
+			Uptime:              100,
+			AverageResponseTime: 250,
+		}},
+	}
+
+	monitor := &pulseticv1.Monitor{}
+	setCheckStatus(monitor, psmonitor)
+	assert.Equal(t, pulseticv1.MonitorStatus{
+		ID:              1,
+		Running:         true,
+		Replicas:        1,
+		State:           pulsetic.StatusOnline,
+		Uptime:          "99.50",
+		ResponseTime:    &metav1.Duration{Duration: 123 * time.Millisecond},
+		LastCheckedAt:   &metav1.Time{Time: checkedAt},
+		StatusChangedAt: &metav1.Time{Time: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)},
+		Nodes: []pulseticv1.NodeStatus{{
+			Title:        "Frankfurt",
+			Location:     "eu",
+			Active:       true,
+			State:        pulsetic.StatusOnline,
+			Uptime:       100,
+			ResponseTime: &metav1.Duration{Duration: 250 * time.Millisecond},
+		}},
+	}, monitor.Status)
+
+	setCheckStatus(monitor, pulsetic.Monitor{ID: 1})
+	assert.Zero(t, monitor.Status.Replicas)
+	assert.Nil(t, monitor.Status.ResponseTime)
+	assert.Nil(t, monitor.Status.LastCheckedAt)
+	assert.Nil(t, monitor.Status.StatusChangedAt)
+	assert.Empty(t, monitor.Status.Nodes)
+}
+
+func Test_parseTime(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want time.Time
+	}{
+		{"empty", "", time.Time{}},
+		{"rfc3339", "2025-01-02T03:04:05.5Z", time.Date(2025, 1, 2, 3, 4, 5, 5e8, time.UTC)},
+		{"date time", "2025-01-02 03:04:05", time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{"invalid", "yesterday", time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, tt.want.Equal(parseTime(tt.s)))
+		})
+	}
+}
+
+func Test_setSSLCertificateStatus(t *testing.T) {
+	expiresAt := time.Now().Add(5 * 24 * time.Hour).Truncate(time.Second)
+	psmonitor := pulsetic.Monitor{
+		SSLCheck: ptr.To(pulsetic.IntBool(true)),
+		SSLCertificate: pulsetic.SSLCertificate{
+			Domain:    "example.com",
+			IssuedBy:  "Example CA",
+			IsValid:   true,
+			ExpiresAt: pulsetic.UnixOrTime(expiresAt),
+		},
+	}
+	monitor := &pulseticv1.Monitor{Spec: pulseticv1.MonitorSpec{SSLExpiryWarningDays: 14}}
+	recorder := record.NewFakeRecorder(10)
+
+	setSSLCertificateStatus(recorder, monitor, psmonitor)
+	require.NotNil(t, monitor.Status.SSLCertificate)
+	assert.True(t, monitor.Status.SSLCertificate.ExpiresAt.Equal(&metav1.Time{Time: expiresAt}))
+	expiring := meta.FindStatusCondition(monitor.Status.Conditions, pulseticv1.ConditionCertificateExpiring)
+	require.NotNil(t, expiring)
+	assert.Equal(t, metav1.ConditionTrue, expiring.Status)
+	assert.Equal(t, "CertificateExpiring", expiring.Reason)
+	assert.Contains(t, expiring.Message, expiresAt.Format(time.RFC3339))
+	assert.Len(t, recorder.Events, 1)
+
+	// Later polls while the certificate is still expiring don't warn again.
+	setSSLCertificateStatus(recorder, monitor, psmonitor)
+	assert.Len(t, recorder.Events, 1)
+
+	psmonitor.SSLCertificate.IsValid = false
+	setSSLCertificateStatus(recorder, monitor, psmonitor)
+	assert.Len(t, recorder.Events, 2)
+}
+
+func Test_setMonitorCondition(t *testing.T) {
+	monitor := &pulseticv1.Monitor{ObjectMeta: metav1.ObjectMeta{Generation: 2}}
+
+	setMonitorCondition(monitor, pulseticv1.ConditionReady, metav1.ConditionTrue, "Synced", "Monitor is synced")
+	ready := meta.FindStatusCondition(monitor.Status.Conditions, pulseticv1.ConditionReady)
+	require.NotNil(t, ready)
+	assert.EqualValues(t, 2, ready.ObservedGeneration)
+	assert.True(t, monitor.Status.Ready, "the deprecated field mirrors the Ready condition")
+
+	setMonitorCondition(monitor, pulseticv1.ConditionSynced, metav1.ConditionFalse, "Failed", "Sync failed")
+	assert.True(t, monitor.Status.Ready)
+
+	setMonitorCondition(monitor, pulseticv1.ConditionReady, metav1.ConditionFalse, "Failed", "Sync failed")
+	assert.False(t, monitor.Status.Ready)
+}
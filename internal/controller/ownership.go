@@ -0,0 +1,86 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"regexp"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+)
+
+// ClusterName identifies this cluster in the names of the monitors it creates.
+// If empty, names are not stamped with an owner.
+//
+//nolint:gochecknoglobals
+var ClusterName = ""
+
+//nolint:gochecknoglobals
+var ownerMarkerRe = regexp.MustCompile(` \[k8s(-keep)?:([^/\]]+)/([^/\]]+)/([^/\]]+)\]$`)
+
+// MonitorOwner identifies the Monitor resource that created a Pulsetic monitor.
+type MonitorOwner struct {
+	Cluster   string
+	Namespace string
+	Name      string
+}
+
+func (o MonitorOwner) String() string {
+	return o.Cluster + "/" + o.Namespace + "/" + o.Name
+}
+
+// ownerOf returns the owner of a monitor in this cluster.
+func ownerOf(monitor *pulseticv1.Monitor) MonitorOwner {
+	return MonitorOwner{Cluster: ClusterName, Namespace: monitor.Namespace, Name: monitor.Name}
+}
+
+// withOwnerMarker appends the owner marker to a monitor name.
+// Monitors that must not be pruned get a keep marker, which garbage collection skips.
+// The name is returned unchanged if ClusterName is not set.
+func withOwnerMarker(name string, owner MonitorOwner, prune bool) string {
+	if owner.Cluster == "" {
+		return name
+	}
+	if !prune {
+		return name + " [k8s-keep:" + owner.String() + "]"
+	}
+	return name + " [k8s:" + owner.String() + "]"
+}
+
+// parseOwnerMarker extracts the owner marker from a Pulsetic monitor name
+// and reports whether the owner allows the monitor to be pruned.
+func parseOwnerMarker(name string) (owner MonitorOwner, prune, ok bool) {
+	matches := ownerMarkerRe.FindStringSubmatch(name)
+	if matches == nil {
+		return MonitorOwner{}, false, false
+	}
+	return MonitorOwner{Cluster: matches[2], Namespace: matches[3], Name: matches[4]}, matches[1] == "", true
+}
+
+// stripOwnerMarker removes the owner marker from a Pulsetic monitor name.
+func stripOwnerMarker(name string) string {
+	return ownerMarkerRe.ReplaceAllString(name, "")
+}
+
+// ownedByOther returns the owner of a Pulsetic monitor if it was created by a different Monitor resource.
+// Monitors without an owner marker are not considered owned.
+func ownedByOther(name string, monitor *pulseticv1.Monitor) (MonitorOwner, bool) {
+	owner, _, ok := parseOwnerMarker(name)
+	if !ok || owner == ownerOf(monitor) {
+		return MonitorOwner{}, false
+	}
+	return owner, true
+}
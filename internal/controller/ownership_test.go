@@ -0,0 +1,78 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_withOwnerMarker(t *testing.T) {
+	owner := MonitorOwner{Cluster: "prod", Namespace: "default", Name: "example"}
+
+	tests := []struct {
+		name  string
+		prune bool
+		want  string
+	}{
+		{"prune", true, "Example [k8s:prod/default/example]"},
+		{"keep", false, "Example [k8s-keep:prod/default/example]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name := withOwnerMarker("Example", owner, tt.prune)
+			assert.Equal(t, tt.want, name)
+
+			parsed, prune, ok := parseOwnerMarker(name)
+			assert.True(t, ok)
+			assert.Equal(t, owner, parsed)
+			assert.Equal(t, tt.prune, prune)
+			assert.Equal(t, "Example", stripOwnerMarker(name))
+		})
+	}
+
+	assert.Equal(t, "Example", withOwnerMarker("Example", MonitorOwner{Namespace: "default", Name: "example"}, true))
+}
+
+func Test_ownedByOther(t *testing.T) {
+	clusterName := ClusterName
+	ClusterName = "prod"
+	t.Cleanup(func() { ClusterName = clusterName })
+
+	monitor := &pulseticv1.Monitor{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "example"}}
+
+	tests := []struct {
+		name      string
+		remote    string
+		wantOwned bool
+	}{
+		{"no marker", "Example", false},
+		{"this resource", "Example [k8s:prod/default/example]", false},
+		{"other cluster", "Example [k8s:staging/default/example]", true},
+		{"other resource", "Example [k8s:prod/default/other]", true},
+		{"kept by other resource", "Example [k8s-keep:prod/default/other]", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, owned := ownedByOther(tt.remote, monitor)
+			assert.Equal(t, tt.wantOwned, owned)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+)
+
+var ErrUnknownRegion = errors.New("unknown region")
+
+// resolveRegions returns the nodes whose title or location matches one of the regions, marked active.
+// Each region must match at least one available node.
+func resolveRegions(available []pulsetic.Node, regions []string) ([]pulsetic.Node, error) {
+	var nodes []pulsetic.Node
+	for _, region := range regions {
+		var found bool
+		for _, node := range available {
+			if !strings.EqualFold(node.Title, region) && !strings.EqualFold(node.Location, region) {
+				continue
+			}
+			found = true
+			if !slices.ContainsFunc(nodes, func(n pulsetic.Node) bool { return n.ID == node.ID }) {
+				node.Active = true
+				nodes = append(nodes, node)
+			}
+		}
+		if !found {
+			names := make([]string, 0, len(available))
+			for _, node := range available {
+				names = append(names, node.Title+" ("+node.Location+")")
+			}
+			return nil, fmt.Errorf("%w %q, available: %s", ErrUnknownRegion, region, strings.Join(names, ", "))
+		}
+	}
+	return nodes, nil
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_resolveRegions(t *testing.T) {
+	available := []pulsetic.Node{
+		{ID: 1, Title: "New York", Location: "US"},
+		{ID: 2, Title: "Dallas", Location: "US"},
+		{ID: 3, Title: "Frankfurt", Location: "DE"},
+	}
+
+	tests := []struct {
+		name    string
+		regions []string
+		want    []int64
+		wantErr require.ErrorAssertionFunc
+	}{
+		{"by title", []string{"frankfurt"}, []int64{3}, require.NoError},
+		{"by location", []string{"US"}, []int64{1, 2}, require.NoError},
+		{"deduplicated", []string{"US", "Dallas"}, []int64{1, 2}, require.NoError},
+		{"unknown", []string{"Tokyo"}, nil, require.Error},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveRegions(available, tt.regions)
+			tt.wantErr(t, err)
+			ids := pulsetic.Monitor{Nodes: got}.ActiveNodeIDs()
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
@@ -0,0 +1,362 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// StatusPageReconciler reconciles a StatusPage object.
+type StatusPageReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+//+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=statuspages,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=statuspages/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=statuspages/finalizers,verbs=update
+//+kubebuilder:rbac:groups=pulsetic.clevyr.com,resources=monitors,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+// Reconcile publishes a StatusPage to Pulsetic with the IDs of its referenced Monitors.
+func (r *StatusPageReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	_ = log.FromContext(ctx)
+
+	page := &pulseticv1.StatusPage{}
+	if err := r.Get(ctx, req.NamespacedName, page); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	account := &pulseticv1.Account{}
+	if err := GetAccount(ctx, r.Client, account, page.Namespace, page.Spec.Account.Name); err != nil {
+		return r.fail(ctx, page, "GetAccountFailed", err)
+	}
+
+	apiKey, err := GetAPIKey(ctx, r.Client, account, page.Namespace)
+	if err != nil {
+		r.Recorder.Event(account, "Warning", "GetAPIKeyFailed", err.Error())
+		return r.fail(ctx, page, "GetAPIKeyFailed", err)
+	}
+	psclient := pulsetic.NewClient(apiKey)
+
+	const myFinalizerName = "pulsetic.clevyr.com/finalizer"
+	if !page.DeletionTimestamp.IsZero() {
+		if controllerutil.ContainsFinalizer(page, myFinalizerName) {
+			if page.Spec.Prune && page.Status.ID != 0 {
+				if err := psclient.StatusPages().Delete(ctx, page.Status.ID); err != nil && !pulsetic.IsNotFound(err) {
+					return r.fail(ctx, page, "DeleteStatusPageFailed", err)
+				}
+				r.Recorder.Event(page, "Normal", "DeleteStatusPageSucceeded",
+					"Deleted status page "+strconv.Quote(page.Name),
+				)
+			}
+
+			controllerutil.RemoveFinalizer(page, myFinalizerName)
+			if err := r.Update(ctx, page); err != nil {
+				r.Recorder.Event(page, "Warning", "RemoveFinalizerFailed", err.Error())
+				return ctrl.Result{}, err
+			}
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	resolved, err := r.resolveSections(ctx, page, account.Name)
+	if err != nil {
+		return r.fail(ctx, page, "ResolveMonitorsFailed", err)
+	}
+
+	desired := pulsetic.StatusPage{
+		Title:        page.Spec.Page.Title,
+		CustomDomain: page.Spec.Page.CustomDomain,
+		LogoURL:      page.Spec.Page.LogoURL,
+		Sections:     resolved.sections,
+	}
+
+	var reason, message string
+	pspage, updated, err := r.updateStatusPage(ctx, psclient, page, desired)
+	switch {
+	case err == nil && !updated:
+		reason = "UpdateSkipped"
+		message = "No update needed for status page " + strconv.Quote(page.Name)
+	case err == nil:
+		reason = "UpdateStatusPageSucceeded"
+		message = "Updated status page " + strconv.Quote(page.Name) + " in " + time.Since(start).String()
+	case errors.Is(err, pulsetic.ErrStatusPageNotFound) && page.Spec.Page.ID == 0:
+		if pspage, err = psclient.StatusPages().Create(ctx, desired); err != nil {
+			return r.fail(ctx, page, "CreateStatusPageFailed", err)
+		}
+		reason = "CreateStatusPageSucceeded"
+		message = "Created status page " + strconv.Quote(page.Name) + " in " + time.Since(start).String()
+	default:
+		return r.fail(ctx, page, "UpdateStatusPageFailed", err)
+	}
+	if reason != "UpdateSkipped" {
+		r.Recorder.Event(page, "Normal", reason, message+", next run in "+page.Spec.Interval.Duration.String())
+	}
+
+	page.Status.ObservedGeneration = page.Generation
+	page.Status.ID = pspage.ID
+	page.Status.URL = pspage.URL
+	page.Status.Monitors = resolved.count
+	page.Status.PendingMonitors = resolved.pending
+
+	readyStatus, readyReason, readyMessage := metav1.ConditionTrue, reason, message
+	switch {
+	case len(resolved.otherAccount) != 0:
+		readyStatus = metav1.ConditionFalse
+		readyReason = "AccountMismatch"
+		readyMessage = "Monitors belong to a different account than " + strconv.Quote(account.Name) + ": " +
+			strings.Join(resolved.otherAccount, ", ")
+		r.Recorder.Event(page, "Warning", readyReason, readyMessage)
+	case len(resolved.pending) != 0:
+		readyStatus = metav1.ConditionFalse
+		readyReason = "MonitorsPending"
+		readyMessage = "Waiting for monitors: " + strings.Join(resolved.pending, ", ")
+	}
+	meta.SetStatusCondition(&page.Status.Conditions, metav1.Condition{
+		Type:               pulseticv1.ConditionSynced,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: page.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+	meta.SetStatusCondition(&page.Status.Conditions, metav1.Condition{
+		Type:               pulseticv1.ConditionReady,
+		Status:             readyStatus,
+		ObservedGeneration: page.Generation,
+		Reason:             readyReason,
+		Message:            readyMessage,
+	})
+	if err := r.Status().Update(ctx, page); err != nil {
+		r.Recorder.Event(page, "Warning", "UpdateStatusFailed", err.Error())
+		return ctrl.Result{}, err
+	}
+
+	if !controllerutil.ContainsFinalizer(page, myFinalizerName) {
+		controllerutil.AddFinalizer(page, myFinalizerName)
+		if err := r.Update(ctx, page); err != nil {
+			r.Recorder.Event(page, "Warning", "AddFinalizerFailed", err.Error())
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: page.Spec.Interval.Duration}, nil
+}
+
+// updateStatusPage updates the Pulsetic status page bound to this resource if it differs from desired,
+// and reports whether it was updated.
+// It returns pulsetic.ErrStatusPageNotFound if the resource is not bound or the page was deleted.
+func (r *StatusPageReconciler) updateStatusPage(
+	ctx context.Context,
+	c pulsetic.Client,
+	page *pulseticv1.StatusPage,
+	desired pulsetic.StatusPage,
+) (pulsetic.StatusPage, bool, error) {
+	id := page.Status.ID
+	if page.Spec.Page.ID != 0 {
+		id = page.Spec.Page.ID
+	}
+	if id == 0 {
+		return pulsetic.StatusPage{}, false, pulsetic.ErrStatusPageNotFound
+	}
+
+	pspage, err := c.StatusPages().FindByID(ctx, id)
+	if err != nil {
+		return pulsetic.StatusPage{}, false, err
+	}
+	if pspage.EditParams().Equal(desired.EditParams()) {
+		return pspage, false, nil
+	}
+
+	pspage, err = c.StatusPages().Update(ctx, id, desired)
+	if pulsetic.IsNotFound(err) {
+		return pulsetic.StatusPage{}, false, pulsetic.ErrStatusPageNotFound
+	}
+	return pspage, err == nil, err
+}
+
+// fail records a Warning event, marks the StatusPage as not ready, and returns err.
+func (r *StatusPageReconciler) fail(
+	ctx context.Context,
+	page *pulseticv1.StatusPage,
+	reason string,
+	err error,
+) (ctrl.Result, error) {
+	r.Recorder.Event(page, "Warning", reason, err.Error())
+
+	page.Status.ObservedGeneration = page.Generation
+	for _, conditionType := range []string{pulseticv1.ConditionSynced, pulseticv1.ConditionReady} {
+		meta.SetStatusCondition(&page.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: page.Generation,
+			Reason:             reason,
+			Message:            err.Error(),
+		})
+	}
+	if err := r.Status().Update(ctx, page); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update status")
+	}
+	return ctrl.Result{}, err
+}
+
+// resolvedSections are the Pulsetic sections for a StatusPage and the Monitors that could not be included.
+type resolvedSections struct {
+	sections []pulsetic.StatusPageSection
+	count    int32
+	// pending lists Monitors that are missing or not yet created in Pulsetic.
+	pending []string
+	// otherAccount lists Monitors that belong to a different Account than the StatusPage.
+	otherAccount []string
+}
+
+// resolveSections converts the Monitors referenced by each section into Pulsetic monitor IDs.
+// Monitors that belong to a different Account are left out, since Pulsetic can't show them on this page.
+func (r *StatusPageReconciler) resolveSections(
+	ctx context.Context,
+	page *pulseticv1.StatusPage,
+	accountName string,
+) (resolvedSections, error) {
+	resolved := resolvedSections{
+		sections: make([]pulsetic.StatusPageSection, 0, len(page.Spec.Page.Sections)),
+	}
+
+	for _, section := range page.Spec.Page.Sections {
+		monitors := make([]*pulseticv1.Monitor, 0, len(section.Monitors))
+		for _, ref := range section.Monitors {
+			monitor := &pulseticv1.Monitor{}
+			if err := r.Get(ctx, client.ObjectKey{Namespace: page.Namespace, Name: ref.Name}, monitor); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return resolvedSections{}, err
+				}
+				monitor.Name = ref.Name
+			}
+			monitors = append(monitors, monitor)
+		}
+
+		if section.Selector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(section.Selector)
+			if err != nil {
+				return resolvedSections{}, err
+			}
+
+			list := &pulseticv1.MonitorList{}
+			if err := r.List(ctx, list,
+				client.InNamespace(page.Namespace),
+				client.MatchingLabelsSelector{Selector: selector},
+			); err != nil {
+				return resolvedSections{}, err
+			}
+			slices.SortFunc(list.Items, func(a, b pulseticv1.Monitor) int {
+				return strings.Compare(a.Name, b.Name)
+			})
+			for i := range list.Items {
+				monitors = append(monitors, &list.Items[i])
+			}
+		}
+
+		ids := make([]int64, 0, len(monitors))
+		for _, monitor := range monitors {
+			switch {
+			case monitor.Status.ID == 0:
+				if !slices.Contains(resolved.pending, monitor.Name) {
+					resolved.pending = append(resolved.pending, monitor.Name)
+				}
+			case monitorAccountName(monitor) != "" && monitorAccountName(monitor) != accountName:
+				if !slices.Contains(resolved.otherAccount, monitor.Name) {
+					resolved.otherAccount = append(resolved.otherAccount, monitor.Name)
+				}
+			case !slices.Contains(ids, monitor.Status.ID):
+				ids = append(ids, monitor.Status.ID)
+				resolved.count++
+			}
+		}
+		resolved.sections = append(resolved.sections, pulsetic.StatusPageSection{Name: section.Name, Monitors: ids})
+	}
+	return resolved, nil
+}
+
+// findStatusPagesForMonitor enqueues the StatusPages in a Monitor's namespace.
+func (r *StatusPageReconciler) findStatusPagesForMonitor(ctx context.Context, obj client.Object) []reconcile.Request {
+	list := &pulseticv1.StatusPageList{}
+	if err := r.List(ctx, list, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list status pages")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, page := range list.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&page)})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *StatusPageReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Only Monitor changes that affect a status page's contents should trigger a reconcile.
+	monitorChanged := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldMonitor, ok := e.ObjectOld.(*pulseticv1.Monitor)
+			if !ok {
+				return false
+			}
+			newMonitor, ok := e.ObjectNew.(*pulseticv1.Monitor)
+			if !ok {
+				return false
+			}
+			return oldMonitor.Status.ID != newMonitor.Status.ID ||
+				monitorAccountName(oldMonitor) != monitorAccountName(newMonitor) ||
+				!maps.Equal(oldMonitor.Labels, newMonitor.Labels)
+		},
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&pulseticv1.StatusPage{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(
+			&pulseticv1.Monitor{},
+			handler.EnqueueRequestsFromMapFunc(r.findStatusPagesForMonitor),
+			builder.WithPredicates(monitorChanged),
+		).
+		Named("statuspage").
+		Complete(r)
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newStatusPageMonitor(name, account string, id int64, labels map[string]string) *pulseticv1.Monitor {
+	monitor := &pulseticv1.Monitor{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, Labels: labels},
+		Status:     pulseticv1.MonitorStatus{ID: id},
+	}
+	if account != "" {
+		monitor.Status.Effective = &pulseticv1.EffectiveMonitorValues{Account: account}
+	}
+	return monitor
+}
+
+func TestStatusPageReconciler_resolveSections(t *testing.T) {
+	web := map[string]string{"tier": "web"}
+	c := fakeClientBuilder(t,
+		newStatusPageMonitor("api", "example", 1, nil),
+		newStatusPageMonitor("www", "example", 2, web),
+		newStatusPageMonitor("docs", "", 3, web),
+		newStatusPageMonitor("new", "example", 0, web),
+		newStatusPageMonitor("foreign", "other", 4, web),
+	).Build()
+	r := &StatusPageReconciler{Client: c}
+
+	page := &pulseticv1.StatusPage{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "example"},
+		Spec: pulseticv1.StatusPageSpec{Page: pulseticv1.StatusPageValues{
+			Sections: []pulseticv1.StatusPageSection{
+				{
+					Name:     "API",
+					Monitors: []corev1.LocalObjectReference{{Name: "api"}, {Name: "missing"}, {Name: "api"}},
+				},
+				{
+					Name:     "Web",
+					Selector: &metav1.LabelSelector{MatchLabels: web},
+				},
+			},
+		}},
+	}
+
+	got, err := r.resolveSections(t.Context(), page, "example")
+	require.NoError(t, err)
+	assert.Equal(t, []pulsetic.StatusPageSection{
+		{Name: "API", Monitors: []int64{1}},
+		{Name: "Web", Monitors: []int64{3, 2}},
+	}, got.sections)
+	assert.EqualValues(t, 3, got.count)
+	assert.Equal(t, []string{"missing", "new"}, got.pending)
+	assert.Equal(t, []string{"foreign"}, got.otherAccount)
+}
+
+func TestStatusPageReconciler_Reconcile(t *testing.T) {
+	tests := []struct {
+		name         string
+		monitors     []*pulseticv1.Monitor
+		wantMonitors []int64
+		wantReady    metav1.ConditionStatus
+		wantReason   string
+	}{
+		{
+			"created",
+			[]*pulseticv1.Monitor{newStatusPageMonitor("api", "example", 1, nil)},
+			[]int64{1},
+			metav1.ConditionTrue,
+			"CreateStatusPageSucceeded",
+		},
+		{
+			"pending",
+			[]*pulseticv1.Monitor{newStatusPageMonitor("api", "example", 0, nil)},
+			[]int64{},
+			metav1.ConditionFalse,
+			"MonitorsPending",
+		},
+		{
+			"other account",
+			[]*pulseticv1.Monitor{newStatusPageMonitor("api", "other", 1, nil)},
+			[]int64{},
+			metav1.ConditionFalse,
+			"AccountMismatch",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, _ := newFakePulsetic(t)
+			account, secret := newTestAccount(t, "example")
+			page := &pulseticv1.StatusPage{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "example", Generation: 1},
+				Spec: pulseticv1.StatusPageSpec{
+					Interval: &metav1.Duration{Duration: time.Hour},
+					Account:  corev1.LocalObjectReference{Name: "example"},
+					Page: pulseticv1.StatusPageValues{
+						Title: "Example",
+						Sections: []pulseticv1.StatusPageSection{{
+							Name:     "API",
+							Monitors: []corev1.LocalObjectReference{{Name: "api"}},
+						}},
+					},
+				},
+			}
+			builder := fakeClientBuilder(t, account, secret, page).WithStatusSubresource(page)
+			for _, monitor := range tt.monitors {
+				builder = builder.WithObjects(monitor)
+			}
+			recorder := record.NewFakeRecorder(10)
+			r := &StatusPageReconciler{Client: builder.Build(), Recorder: recorder}
+
+			_, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(page)})
+			require.NoError(t, err)
+
+			pages := fake.StatusPages()
+			require.Len(t, pages, 1)
+			assert.Equal(t, "Example", pages[0].Title)
+			assert.Equal(t, []pulsetic.StatusPageSection{{Name: "API", Monitors: tt.wantMonitors}}, pages[0].Sections)
+
+			got := &pulseticv1.StatusPage{}
+			require.NoError(t, r.Get(t.Context(), client.ObjectKeyFromObject(page), got))
+			assert.Equal(t, pages[0].ID, got.Status.ID)
+			assert.Equal(t, pages[0].URL, got.Status.URL)
+			ready := meta.FindStatusCondition(got.Status.Conditions, pulseticv1.ConditionReady)
+			require.NotNil(t, ready)
+			assert.Equal(t, tt.wantReady, ready.Status)
+			assert.Equal(t, tt.wantReason, ready.Reason)
+			assert.Contains(t, got.Finalizers, "pulsetic.clevyr.com/finalizer")
+
+			// A second reconcile finds the page it created unchanged and skips the update.
+			for len(recorder.Events) != 0 {
+				<-recorder.Events
+			}
+			_, err = r.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(page)})
+			require.NoError(t, err)
+			assert.Equal(t, pages, fake.StatusPages())
+			require.NoError(t, r.Get(t.Context(), client.ObjectKeyFromObject(page), got))
+			synced := meta.FindStatusCondition(got.Status.Conditions, pulseticv1.ConditionSynced)
+			require.NotNil(t, synced)
+			assert.Equal(t, "UpdateSkipped", synced.Reason)
+			for len(recorder.Events) != 0 {
+				assert.NotContains(t, <-recorder.Events, "Normal")
+			}
+
+			// A changed spec is pushed to the page.
+			got.Spec.Page.Title = "Renamed"
+			require.NoError(t, r.Update(t.Context(), got))
+			_, err = r.Reconcile(t.Context(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(page)})
+			require.NoError(t, err)
+			pages = fake.StatusPages()
+			require.Len(t, pages, 1)
+			assert.Equal(t, "Renamed", pages[0].Title)
+			require.NoError(t, r.Get(t.Context(), client.ObjectKeyFromObject(page), got))
+			synced = meta.FindStatusCondition(got.Status.Conditions, pulseticv1.ConditionSynced)
+			require.NotNil(t, synced)
+			assert.Equal(t, "UpdateStatusPageSucceeded", synced.Reason)
+		})
+	}
+}
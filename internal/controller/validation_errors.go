@@ -0,0 +1,88 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+)
+
+// monitorFieldPaths maps Pulsetic monitor parameters to the Monitor fields they are built from.
+//
+//nolint:gochecknoglobals
+var monitorFieldPaths = map[string]string{
+	"url":                        "spec.monitor.url",
+	"name":                       "spec.monitor.name",
+	"uptime_check_frequency":     "spec.monitor.interval",
+	"offline_notification_delay": "spec.monitor.offlineNotificationDelay",
+	"ssl_check":                  "spec.monitor.sslCheck",
+	"is_negative":                "spec.monitor.response.bodyNotContains",
+	"tcp_ports":                  "spec.monitor.ports",
+	"lighthouse_audit_enabled":   "spec.monitor.lighthouse.enabled",
+	"nodes":                      "spec.monitor.regions",
+	"request.type":               "spec.monitor.type",
+	"request.method":             "spec.monitor.method",
+	"request.timeout":            "spec.monitor.timeout",
+	"request.headers":            "spec.monitor.request.headers",
+	"request.body_type":          "spec.monitor.request.body",
+	"request.body_raw":           "spec.monitor.request.body",
+	"request.body_json":          "spec.monitor.request.body",
+	"request.body_form_params":   "spec.monitor.request.body.form",
+	"response.body":              "spec.monitor.response",
+	"response.headers":           "spec.monitor.response.headers",
+	"response.expected_code":     "spec.monitor.response.expectedStatusCode",
+}
+
+// monitorValidationMessage converts a Pulsetic validation error into a message that
+// references Monitor field paths, one line per field.
+// It returns false if err is not a validation error.
+func monitorValidationMessage(err error) (string, bool) {
+	var resErr pulsetic.ResponseError
+	if !pulsetic.IsValidationError(err) || !errors.As(err, &resErr) {
+		return "", false
+	}
+	if len(resErr.Errors) == 0 {
+		return resErr.Message, true
+	}
+
+	lines := make([]string, 0, len(resErr.Errors))
+	for key, msgs := range resErr.Errors {
+		lines = append(lines, monitorFieldPath(key)+": "+strings.Join(msgs, ", "))
+	}
+	slices.Sort(lines)
+	return strings.Join(lines, "\n"), true
+}
+
+// monitorFieldPath returns the Monitor field path for a Pulsetic parameter.
+// Nested keys such as "request.headers.0.name" resolve to their closest known parent.
+// Unknown parameters are returned unchanged.
+func monitorFieldPath(key string) string {
+	for k := key; k != ""; {
+		if path, ok := monitorFieldPaths[k]; ok {
+			return path
+		}
+		i := strings.LastIndexByte(k, '.')
+		if i == -1 {
+			break
+		}
+		k = k[:i]
+	}
+	return key
+}
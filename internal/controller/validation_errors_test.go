@@ -0,0 +1,73 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_monitorFieldPath(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"request.timeout", "spec.monitor.timeout"},
+		{"uptime_check_frequency", "spec.monitor.interval"},
+		{"request.headers.0.name", "spec.monitor.request.headers"},
+		{"unknown", "unknown"},
+		{"unknown.nested", "unknown.nested"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			assert.Equal(t, tt.want, monitorFieldPath(tt.key))
+		})
+	}
+}
+
+func Test_monitorValidationMessage(t *testing.T) {
+	resErr := pulsetic.ResponseError{
+		Response: &http.Response{StatusCode: http.StatusUnprocessableEntity},
+		Message:  "The given data was invalid.",
+		Errors: map[string][]string{
+			"request.timeout":        {"The request.timeout must be at least 1."},
+			"uptime_check_frequency": {"The selected uptime check frequency is invalid."},
+		},
+	}
+
+	msg, ok := monitorValidationMessage(fmt.Errorf("%w: body", resErr))
+	assert.True(t, ok)
+	assert.Equal(t, "spec.monitor.interval: The selected uptime check frequency is invalid.\n"+
+		"spec.monitor.timeout: The request.timeout must be at least 1.", msg)
+
+	resErr.Errors = nil
+	msg, ok = monitorValidationMessage(resErr)
+	assert.True(t, ok)
+	assert.Equal(t, "The given data was invalid.", msg)
+
+	resErr.Response.StatusCode = http.StatusInternalServerError
+	_, ok = monitorValidationMessage(resErr)
+	assert.False(t, ok)
+
+	_, ok = monitorValidationMessage(errors.New("connection refused")) //nolint:err113
+	assert.False(t, ok)
+}
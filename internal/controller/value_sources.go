@@ -0,0 +1,121 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const secretRefsField = "spec.monitor.secretRefs"
+
+type valueSourceRef struct {
+	field  string
+	source *pulseticv1.ValueSource
+	value  *string
+}
+
+// valueSourceRefs returns every ValueFrom in values along with the field it populates.
+func valueSourceRefs(values *pulseticv1.MonitorValues) []valueSourceRef {
+	var refs []valueSourceRef
+	if values.Request != nil {
+		for i := range values.Request.Headers {
+			header := &values.Request.Headers[i]
+			if header.ValueFrom != nil {
+				refs = append(refs, valueSourceRef{"header " + header.Name, header.ValueFrom, &header.Value})
+			}
+		}
+
+		if body := values.Request.Body; body != nil {
+			if body.RawFrom != nil {
+				refs = append(refs, valueSourceRef{"raw body", body.RawFrom, &body.Raw})
+			}
+			if body.JSONFrom != nil {
+				refs = append(refs, valueSourceRef{"JSON body", body.JSONFrom, &body.JSON})
+			}
+			for i := range body.Form {
+				param := &body.Form[i]
+				if param.ValueFrom != nil {
+					refs = append(refs, valueSourceRef{"form param " + param.Name, param.ValueFrom, &param.Value})
+				}
+			}
+		}
+	}
+	if values.Response != nil {
+		for i := range values.Response.Headers {
+			header := &values.Response.Headers[i]
+			if header.ValueFrom != nil {
+				refs = append(refs, valueSourceRef{"response header " + header.Name, header.ValueFrom, &header.Value})
+			}
+		}
+	}
+	return refs
+}
+
+// ResolveMonitorValues returns a copy of values with all ValueFrom references replaced by their current value.
+func ResolveMonitorValues(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	values pulseticv1.MonitorValues,
+) (pulseticv1.MonitorValues, error) {
+	resolved := *values.DeepCopy()
+	for _, ref := range valueSourceRefs(&resolved) {
+		if ref.source.SecretKeyRef == nil {
+			continue
+		}
+
+		val, err := GetSecretValue(ctx, c, namespace, *ref.source.SecretKeyRef)
+		if err != nil {
+			return resolved, fmt.Errorf("%s: %w", ref.field, err)
+		}
+		*ref.value = val
+	}
+	return resolved, nil
+}
+
+func indexMonitorSecretRefs(rawObj client.Object) []string {
+	monitor := rawObj.(*pulseticv1.Monitor) //nolint:errcheck
+	refs := valueSourceRefs(&monitor.Spec.Monitor)
+	names := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		if ref.source.SecretKeyRef != nil {
+			names = append(names, ref.source.SecretKeyRef.Name)
+		}
+	}
+	return names
+}
+
+func (r *MonitorReconciler) findMonitorsForSecret(ctx context.Context, secret client.Object) []reconcile.Request {
+	list := &pulseticv1.MonitorList{}
+	if err := r.List(ctx, list,
+		client.InNamespace(secret.GetNamespace()),
+		client.MatchingFields{secretRefsField: secret.GetName()},
+	); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, monitor := range list.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&monitor)})
+	}
+	return requests
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func secretSource(name, key string) *pulseticv1.ValueSource {
+	return &pulseticv1.ValueSource{SecretKeyRef: &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: name},
+		Key:                  key,
+	}}
+}
+
+func TestResolveMonitorValues(t *testing.T) {
+	c := fakeClientBuilder(t, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "example"},
+		Data:       map[string][]byte{"token": []byte("Bearer token"), "body": []byte(`{"a":"b"}`)},
+	}).Build()
+
+	tests := []struct {
+		name    string
+		values  pulseticv1.MonitorValues
+		want    pulseticv1.MonitorValues
+		wantErr string
+	}{
+		{
+			"no sources",
+			pulseticv1.MonitorValues{Request: &pulseticv1.MonitorRequest{
+				Headers: []pulseticv1.Header{{Name: "A", Value: "B"}},
+			}},
+			pulseticv1.MonitorValues{Request: &pulseticv1.MonitorRequest{
+				Headers: []pulseticv1.Header{{Name: "A", Value: "B"}},
+			}},
+			"",
+		},
+		{
+			"header and body",
+			pulseticv1.MonitorValues{Request: &pulseticv1.MonitorRequest{
+				Headers: []pulseticv1.Header{{Name: "Authorization", ValueFrom: secretSource("example", "token")}},
+				Body:    &pulseticv1.RequestBody{JSONFrom: secretSource("example", "body")},
+			}},
+			pulseticv1.MonitorValues{Request: &pulseticv1.MonitorRequest{
+				Headers: []pulseticv1.Header{{
+					Name: "Authorization", Value: "Bearer token", ValueFrom: secretSource("example", "token"),
+				}},
+				Body: &pulseticv1.RequestBody{JSON: `{"a":"b"}`, JSONFrom: secretSource("example", "body")},
+			}},
+			"",
+		},
+		{
+			"form param",
+			pulseticv1.MonitorValues{Request: &pulseticv1.MonitorRequest{
+				Body: &pulseticv1.RequestBody{Form: []pulseticv1.FormParam{
+					{Name: "token", ValueFrom: secretSource("example", "token")},
+				}},
+			}},
+			pulseticv1.MonitorValues{Request: &pulseticv1.MonitorRequest{
+				Body: &pulseticv1.RequestBody{Form: []pulseticv1.FormParam{
+					{Name: "token", Value: "Bearer token", ValueFrom: secretSource("example", "token")},
+				}},
+			}},
+			"",
+		},
+		{
+			"missing secret",
+			pulseticv1.MonitorValues{Response: &pulseticv1.MonitorResponse{
+				Headers: []pulseticv1.Header{{Name: "X-Token", ValueFrom: secretSource("missing", "token")}},
+			}},
+			pulseticv1.MonitorValues{},
+			"response header X-Token",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := *tt.values.DeepCopy()
+			got, err := ResolveMonitorValues(t.Context(), c, "default", tt.values)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, original, tt.values, "the input should not be modified")
+		})
+	}
+}
+
+func TestMonitorReconciler_findMonitorsForSecret(t *testing.T) {
+	monitor := func(namespace, name string, values pulseticv1.MonitorValues) *pulseticv1.Monitor {
+		return &pulseticv1.Monitor{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       pulseticv1.MonitorSpec{Monitor: values},
+		}
+	}
+	headers := pulseticv1.MonitorValues{Request: &pulseticv1.MonitorRequest{
+		Headers: []pulseticv1.Header{{Name: "Authorization", ValueFrom: secretSource("example", "token")}},
+	}}
+	body := pulseticv1.MonitorValues{Request: &pulseticv1.MonitorRequest{
+		Body: &pulseticv1.RequestBody{RawFrom: secretSource("example", "body")},
+	}}
+
+	c := fakeClientBuilder(t,
+		monitor("default", "headers", headers),
+		monitor("default", "body", body),
+		monitor("default", "plain", pulseticv1.MonitorValues{}),
+		monitor("other", "headers", headers),
+	).WithIndex(&pulseticv1.Monitor{}, secretRefsField, indexMonitorSecretRefs).Build()
+
+	r := &MonitorReconciler{Client: c}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "example"}}
+	assert.ElementsMatch(t, []reconcile.Request{
+		{NamespacedName: client.ObjectKey{Namespace: "default", Name: "headers"}},
+		{NamespacedName: client.ObjectKey{Namespace: "default", Name: "body"}},
+	}, r.findMonitorsForSecret(t.Context(), secret))
+}
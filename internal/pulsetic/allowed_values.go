@@ -0,0 +1,58 @@
+package pulsetic
+
+import (
+	"slices"
+	"time"
+)
+
+// CheckFrequencies are the uptime check frequencies accepted by Pulsetic.
+//
+//nolint:gochecknoglobals
+var CheckFrequencies = []time.Duration{
+	30 * time.Second,
+	time.Minute,
+	2 * time.Minute,
+	3 * time.Minute,
+	5 * time.Minute,
+	10 * time.Minute,
+	15 * time.Minute,
+	30 * time.Minute,
+	time.Hour,
+	12 * time.Hour,
+	24 * time.Hour,
+}
+
+// OfflineNotificationDelays are the offline notification delays accepted by Pulsetic.
+//
+//nolint:gochecknoglobals
+var OfflineNotificationDelays = []time.Duration{
+	0,
+	time.Minute,
+	2 * time.Minute,
+	3 * time.Minute,
+	5 * time.Minute,
+	10 * time.Minute,
+	15 * time.Minute,
+	30 * time.Minute,
+	time.Hour,
+}
+
+// Nearest returns the value in allowed that is closest to d, preferring the larger value on a tie.
+// allowed must be sorted in ascending order.
+func Nearest(allowed []time.Duration, d time.Duration) time.Duration {
+	i, found := slices.BinarySearch(allowed, d)
+	switch {
+	case found:
+		return d
+	case i == 0:
+		return allowed[0]
+	case i == len(allowed):
+		return allowed[len(allowed)-1]
+	}
+
+	lower, upper := allowed[i-1], allowed[i]
+	if d-lower < upper-d {
+		return lower
+	}
+	return upper
+}
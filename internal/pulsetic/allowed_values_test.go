@@ -0,0 +1,28 @@
+package pulsetic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNearest(t *testing.T) {
+	tests := []struct {
+		name string
+		d    time.Duration
+		want time.Duration
+	}{
+		{"allowed", 5 * time.Minute, 5 * time.Minute},
+		{"below minimum", 10 * time.Second, 30 * time.Second},
+		{"above maximum", 48 * time.Hour, 24 * time.Hour},
+		{"rounds down", 70 * time.Second, time.Minute},
+		{"rounds up", 110 * time.Second, 2 * time.Minute},
+		{"tie", 45 * time.Second, time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Nearest(CheckFrequencies, tt.d))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package pulsetic
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 )
 
 func NewClient(apiKey string) Client {
@@ -16,12 +18,22 @@ func NewClient(apiKey string) Client {
 		api = strings.TrimSuffix(env, "/")
 	}
 
-	return Client{url: api, apiKey: apiKey}
+	opts := DefaultOptions
+	return Client{
+		url:     api,
+		apiKey:  apiKey,
+		opts:    opts,
+		http:    &http.Client{Timeout: opts.Timeout},
+		limiter: limiterFor(apiKey, opts, time.Now()),
+	}
 }
 
 type Client struct {
-	url    string
-	apiKey string
+	url     string
+	apiKey  string
+	opts    Options
+	http    *http.Client
+	limiter *sharedLimiter
 }
 
 func (c Client) NewRequest(ctx context.Context, method, endpoint string, body io.Reader) (*http.Request, error) {
@@ -40,30 +52,94 @@ func (c Client) NewRequest(ctx context.Context, method, endpoint string, body io
 	return req, nil
 }
 
+// Do sends a request to the Pulsetic API.
+// Requests wait for the API key's rate limiter and are retried according to the client's Options.
+// Responses with a status of 400 or above are returned as a ResponseError.
 func (c Client) Do(ctx context.Context, method, endpoint string, body io.Reader) (*http.Response, error) {
-	req, err := c.NewRequest(ctx, method, endpoint, body)
-	if err != nil {
-		return nil, err
+	// Buffer the body so that it can be sent again on retry.
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = io.ReadAll(body); err != nil {
+			return nil, err
+		}
 	}
 
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, err
+	httpClient := c.http
+	if httpClient == nil {
+		httpClient = http.DefaultClient
 	}
 
-	if res.StatusCode > 400 {
-		errRes := ResponseError{Response: res}
-		if b, err := io.ReadAll(res.Body); err == nil {
-			if err := json.Unmarshal(b, &errRes); err != nil {
-				return nil, fmt.Errorf("%w: %s", errRes, b)
+	for attempt := 0; ; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.Wait(ctx); err != nil {
+				return nil, err
 			}
 		}
-		return nil, errRes
+
+		var body io.Reader
+		if payload != nil {
+			body = bytes.NewReader(payload)
+		}
+		req, err := c.NewRequest(ctx, method, endpoint, body)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := httpClient.Do(req)
+		if attempt >= c.opts.MaxRetries || ctx.Err() != nil || !shouldRetry(method, res, err) {
+			if err != nil {
+				return nil, err
+			}
+			return checkResponse(res)
+		}
+
+		delay := retryDelay(c.opts, attempt, res, time.Now())
+		if res != nil {
+			consumeAndClose(res.Body)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func checkResponse(res *http.Response) (*http.Response, error) {
+	if res.StatusCode < http.StatusBadRequest {
+		return res, nil
 	}
 
-	return res, nil
+	defer consumeAndClose(res.Body)
+	errRes := ResponseError{Response: res}
+	if b, err := io.ReadAll(res.Body); err == nil {
+		if err := json.Unmarshal(b, &errRes); err != nil {
+			return nil, fmt.Errorf("%w: %s", errRes, b)
+		}
+	}
+	return nil, errRes
 }
 
 func (c Client) Monitors() MonitorClient {
 	return MonitorClient{client: c}
 }
+
+func (c Client) StatusPages() StatusPageClient {
+	return StatusPageClient{client: c}
+}
+
+func (c Client) Contacts() ContactClient {
+	return ContactClient{client: c}
+}
+
+func (c Client) Incidents() IncidentClient {
+	return IncidentClient{client: c}
+}
+
+func (c Client) Nodes() NodeClient {
+	return NodeClient{client: c}
+}
@@ -0,0 +1,138 @@
+package pulsetic
+
+import (
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) Client {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return Client{
+		url:    srv.URL,
+		apiKey: "test",
+		opts:   Options{MaxRetries: 2, RetryBaseDelay: time.Millisecond},
+		http:   srv.Client(),
+	}
+}
+
+func TestClient_Do(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		statuses     []int
+		wantAttempts int32
+		wantErr      require.ErrorAssertionFunc
+	}{
+		{"success", http.MethodGet, []int{http.StatusOK}, 1, require.NoError},
+		{"bad request", http.MethodGet, []int{http.StatusBadRequest}, 1, require.Error},
+		{"retries server error", http.MethodGet, []int{http.StatusServiceUnavailable, http.StatusOK}, 2, require.NoError},
+		{"gives up", http.MethodPut, []int{http.StatusBadGateway}, 3, require.Error},
+		{"does not retry post", http.MethodPost, []int{http.StatusServiceUnavailable, http.StatusOK}, 1, require.Error},
+		{"retries rate limited post", http.MethodPost, []int{http.StatusTooManyRequests, http.StatusOK}, 2, require.NoError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				b, _ := io.ReadAll(r.Body)
+				assert.Equal(t, "body", string(b))
+
+				i := int(attempts.Add(1)) - 1
+				status := tt.statuses[min(i, len(tt.statuses)-1)]
+				if status == http.StatusTooManyRequests {
+					w.Header().Set("Retry-After", "0")
+				}
+				w.WriteHeader(status)
+			})
+
+			res, err := c.Do(t.Context(), tt.method, "monitors", strings.NewReader("body"))
+			tt.wantErr(t, err)
+			if res != nil {
+				_ = res.Body.Close()
+			}
+			assert.Equal(t, tt.wantAttempts, attempts.Load())
+		})
+	}
+}
+
+func Test_retryDelay(t *testing.T) {
+	now := time.Unix(time.Now().Unix(), 0)
+	opts := Options{RetryBaseDelay: time.Second, MaxRetryDelay: time.Minute}
+
+	header := func(k, v string) *http.Response {
+		res := &http.Response{Header: http.Header{}}
+		res.Header.Set(k, v)
+		return res
+	}
+
+	tests := []struct {
+		name    string
+		attempt int
+		res     *http.Response
+		want    time.Duration
+	}{
+		{"first attempt", 0, nil, time.Second},
+		{"exponential", 2, nil, 4 * time.Second},
+		{"capped", 10, nil, time.Minute},
+		{"capped without overflow", 40, nil, time.Minute},
+		{"capped past shift width", 100, nil, time.Minute},
+		{"retry after seconds", 0, header("Retry-After", "5"), 5 * time.Second},
+		{"retry after date", 0, header("Retry-After", now.Add(10*time.Second).UTC().Format(http.TimeFormat)), 10 * time.Second},
+		{"rate limit reset", 0, header("X-RateLimit-Reset", strconv.FormatInt(now.Add(20*time.Second).Unix(), 10)), 20 * time.Second},
+		{"retry after capped", 0, header("Retry-After", "3600"), time.Minute},
+		{"retry after overflow", 0, header("Retry-After", "9223372036854775807"), time.Minute},
+		{"retry after negative", 1, header("Retry-After", "-5"), 2 * time.Second},
+		{"retry after negative overflow", 1, header("Retry-After", "-9223372036854775808"), 2 * time.Second},
+		{"retry after past date", 1, header("Retry-After", now.Add(-time.Hour).UTC().Format(http.TimeFormat)), 2 * time.Second},
+		{"rate limit reset past", 1, header("X-RateLimit-Reset", strconv.FormatInt(now.Add(-time.Hour).Unix(), 10)), 2 * time.Second},
+		{"rate limit reset overflow", 0, header("X-RateLimit-Reset", "9223372036854775807"), time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, retryDelay(opts, tt.attempt, tt.res, now))
+		})
+	}
+
+	t.Run("uncapped", func(t *testing.T) {
+		opts := Options{RetryBaseDelay: time.Second}
+		assert.Equal(t, 4*time.Second, retryDelay(opts, 2, nil, now))
+		assert.Equal(t, time.Duration(math.MaxInt64), retryDelay(opts, 40, nil, now))
+		res := header("Retry-After", "9223372036854775807")
+		assert.Equal(t, time.Duration(math.MaxInt64), retryDelay(opts, 0, res, now))
+	})
+}
+
+func Test_limiterFor(t *testing.T) {
+	now := time.Now()
+	opts := Options{RateLimit: 1, Burst: 1}
+
+	l := limiterFor(t.Name(), opts, now)
+	assert.Same(t, l, limiterFor(t.Name(), opts, now.Add(time.Minute)))
+
+	limiters.Range(func(k, _ any) bool {
+		assert.NotEqual(t, t.Name(), k, "limiters must not be keyed by the raw API key")
+		return true
+	})
+
+	// An idle limiter is evicted, and the key gets a new one.
+	later := now.Add(time.Minute + limiterIdleTimeout + time.Second)
+	limiterFor(t.Name()+"-other", opts, later)
+	var found bool
+	limiters.Range(func(_, v any) bool {
+		found = found || v == l
+		return true
+	})
+	assert.False(t, found)
+	assert.NotSame(t, l, limiterFor(t.Name(), opts, later))
+}
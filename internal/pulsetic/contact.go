@@ -0,0 +1,33 @@
+package pulsetic
+
+type ContactType string
+
+const (
+	ContactTypeEmail    ContactType = "email"
+	ContactTypeSlack    ContactType = "slack"
+	ContactTypeWebhook  ContactType = "webhook"
+	ContactTypeTelegram ContactType = "telegram"
+)
+
+// Contact is a notification channel that is alerted when a monitor changes state.
+type Contact struct {
+	ID   int64       `json:"id"`
+	Name string      `json:"name"`
+	Type ContactType `json:"type"`
+	// Value is the email address, Slack or webhook URL, or Telegram chat ID.
+	Value string `json:"value"`
+}
+
+type ContactEditParams struct {
+	Name  string      `json:"name"`
+	Type  ContactType `json:"type"`
+	Value string      `json:"value"`
+}
+
+func (c Contact) EditParams() ContactEditParams {
+	return ContactEditParams{
+		Name:  c.Name,
+		Type:  c.Type,
+		Value: c.Value,
+	}
+}
@@ -0,0 +1,66 @@
+//nolint:bodyclose
+package pulsetic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"path"
+	"strconv"
+)
+
+var ErrContactNotFound = errors.New("contact not found")
+
+type ContactClient struct {
+	client Client
+}
+
+const endpointContacts = "contacts"
+
+type ContactResponse struct {
+	Data Contact `json:"data"`
+}
+
+func (c ContactClient) Create(ctx context.Context, contact Contact) (Contact, error) {
+	return c.send(ctx, http.MethodPost, endpointContacts, contact)
+}
+
+func (c ContactClient) Update(ctx context.Context, id int64, contact Contact) (Contact, error) {
+	u := path.Join(endpointContacts, strconv.FormatInt(id, 10))
+	contact, err := c.send(ctx, http.MethodPut, u, contact)
+	if IsNotFound(err) {
+		return Contact{}, ErrContactNotFound
+	}
+	return contact, err
+}
+
+func (c ContactClient) Delete(ctx context.Context, id int64) error {
+	u := path.Join(endpointContacts, strconv.FormatInt(id, 10))
+	res, err := c.client.Do(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return err
+	}
+	defer consumeAndClose(res.Body)
+	return nil
+}
+
+func (c ContactClient) send(ctx context.Context, method, endpoint string, contact Contact) (Contact, error) {
+	b, err := json.Marshal(contact.EditParams())
+	if err != nil {
+		return Contact{}, err
+	}
+
+	res, err := c.client.Do(ctx, method, endpoint, bytes.NewReader(b))
+	if err != nil {
+		return Contact{}, err
+	}
+	defer consumeAndClose(res.Body)
+
+	var parsed ContactResponse
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return Contact{}, err
+	}
+	return parsed.Data, nil
+}
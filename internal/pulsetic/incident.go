@@ -0,0 +1,55 @@
+package pulsetic
+
+type IncidentSeverity string
+
+const (
+	IncidentSeverityMinor    IncidentSeverity = "minor"
+	IncidentSeverityMajor    IncidentSeverity = "major"
+	IncidentSeverityCritical IncidentSeverity = "critical"
+)
+
+type IncidentStatus string
+
+const (
+	IncidentStatusInvestigating IncidentStatus = "investigating"
+	IncidentStatusIdentified    IncidentStatus = "identified"
+	IncidentStatusMonitoring    IncidentStatus = "monitoring"
+	IncidentStatusResolved      IncidentStatus = "resolved"
+)
+
+type Incident struct {
+	ID           int64            `json:"id"`
+	Title        string           `json:"title"`
+	Severity     IncidentSeverity `json:"severity"`
+	Status       IncidentStatus   `json:"status"`
+	StatusPageID int64            `json:"status_page_id"`
+	Monitors     []int64          `json:"monitors"`
+	Updates      []IncidentUpdate `json:"updates"`
+	ResolvedAt   UnixOrTime       `json:"resolved_at"`
+}
+
+type IncidentUpdate struct {
+	ID      int64          `json:"id,omitzero"`
+	Status  IncidentStatus `json:"status"`
+	Message string         `json:"message"`
+}
+
+type IncidentEditParams struct {
+	Title        string           `json:"title"`
+	Severity     IncidentSeverity `json:"severity"`
+	StatusPageID int64            `json:"status_page_id,omitzero"`
+	Monitors     []int64          `json:"monitors"`
+}
+
+func (i Incident) EditParams() IncidentEditParams {
+	monitors := i.Monitors
+	if monitors == nil {
+		monitors = []int64{}
+	}
+	return IncidentEditParams{
+		Title:        i.Title,
+		Severity:     i.Severity,
+		StatusPageID: i.StatusPageID,
+		Monitors:     monitors,
+	}
+}
@@ -0,0 +1,67 @@
+//nolint:bodyclose
+package pulsetic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"path"
+	"strconv"
+)
+
+var ErrIncidentNotFound = errors.New("incident not found")
+
+type IncidentClient struct {
+	client Client
+}
+
+const endpointIncidents = "incidents"
+
+type IncidentResponse struct {
+	Data Incident `json:"data"`
+}
+
+func (i IncidentClient) Create(ctx context.Context, incident Incident) (Incident, error) {
+	return i.send(ctx, http.MethodPost, endpointIncidents, incident.EditParams())
+}
+
+func (i IncidentClient) Update(ctx context.Context, id int64, incident Incident) (Incident, error) {
+	u := path.Join(endpointIncidents, strconv.FormatInt(id, 10))
+	incident, err := i.send(ctx, http.MethodPut, u, incident.EditParams())
+	if IsNotFound(err) {
+		return Incident{}, ErrIncidentNotFound
+	}
+	return incident, err
+}
+
+// AddUpdate posts a timeline update to an incident.
+func (i IncidentClient) AddUpdate(ctx context.Context, id int64, update IncidentUpdate) (Incident, error) {
+	u := path.Join(endpointIncidents, strconv.FormatInt(id, 10), "updates")
+	return i.send(ctx, http.MethodPost, u, update)
+}
+
+// Resolve marks an incident as resolved with a final timeline update.
+func (i IncidentClient) Resolve(ctx context.Context, id int64, message string) (Incident, error) {
+	return i.AddUpdate(ctx, id, IncidentUpdate{Status: IncidentStatusResolved, Message: message})
+}
+
+func (i IncidentClient) send(ctx context.Context, method, endpoint string, body any) (Incident, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return Incident{}, err
+	}
+
+	res, err := i.client.Do(ctx, method, endpoint, bytes.NewReader(b))
+	if err != nil {
+		return Incident{}, err
+	}
+	defer consumeAndClose(res.Body)
+
+	var parsed IncidentResponse
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return Incident{}, err
+	}
+	return parsed.Data, nil
+}
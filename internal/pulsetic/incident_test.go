@@ -0,0 +1,120 @@
+package pulsetic
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncident_EditParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		incident Incident
+		want     string
+	}{
+		{
+			"full",
+			Incident{
+				ID:           1,
+				Title:        "Outage",
+				Severity:     IncidentSeverityMajor,
+				Status:       IncidentStatusIdentified,
+				StatusPageID: 2,
+				Monitors:     []int64{3, 4},
+			},
+			`{"title":"Outage","severity":"major","status_page_id":2,"monitors":[3,4]}`,
+		},
+		{
+			"no monitors or status page",
+			Incident{Title: "Outage", Severity: IncidentSeverityMinor},
+			`{"title":"Outage","severity":"minor","monitors":[]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.incident.EditParams())
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(b))
+		})
+	}
+}
+
+func TestIncidentClient(t *testing.T) {
+	incident := Incident{Title: "Outage", Severity: IncidentSeverityMinor}
+	const params = `{"title":"Outage","severity":"minor","monitors":[]}`
+
+	tests := []struct {
+		name     string
+		call     func(c IncidentClient) (Incident, error)
+		method   string
+		path     string
+		body     string
+		status   int
+		response string
+		want     Incident
+		wantErr  error
+	}{
+		{
+			"create",
+			func(c IncidentClient) (Incident, error) { return c.Create(t.Context(), incident) },
+			http.MethodPost, "/incidents", params, http.StatusOK,
+			`{"data":{"id":1,"title":"Outage","status":"investigating"}}`,
+			Incident{ID: 1, Title: "Outage", Status: IncidentStatusInvestigating}, nil,
+		},
+		{
+			"update",
+			func(c IncidentClient) (Incident, error) { return c.Update(t.Context(), 1, incident) },
+			http.MethodPut, "/incidents/1", params, http.StatusOK,
+			`{"data":{"id":1,"title":"Outage"}}`,
+			Incident{ID: 1, Title: "Outage"}, nil,
+		},
+		{
+			"update not found",
+			func(c IncidentClient) (Incident, error) { return c.Update(t.Context(), 1, incident) },
+			http.MethodPut, "/incidents/1", params, http.StatusNotFound,
+			`{"message":"Not found"}`,
+			Incident{}, ErrIncidentNotFound,
+		},
+		{
+			"add update",
+			func(c IncidentClient) (Incident, error) {
+				return c.AddUpdate(t.Context(), 1, IncidentUpdate{Status: IncidentStatusMonitoring, Message: "Fix deployed"})
+			},
+			http.MethodPost, "/incidents/1/updates", `{"status":"monitoring","message":"Fix deployed"}`, http.StatusOK,
+			`{"data":{"id":1,"status":"monitoring"}}`,
+			Incident{ID: 1, Status: IncidentStatusMonitoring}, nil,
+		},
+		{
+			"resolve",
+			func(c IncidentClient) (Incident, error) { return c.Resolve(t.Context(), 1, "Fixed") },
+			http.MethodPost, "/incidents/1/updates", `{"status":"resolved","message":"Fixed"}`, http.StatusOK,
+			`{"data":{"id":1,"status":"resolved","resolved_at":1700000000}}`,
+			Incident{ID: 1, Status: IncidentStatusResolved, ResolvedAt: UnixOrTime(time.Unix(1700000000, 0))}, nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.method, r.Method)
+				assert.Equal(t, tt.path, r.URL.Path)
+				b, _ := io.ReadAll(r.Body)
+				assert.JSONEq(t, tt.body, string(b))
+				w.WriteHeader(tt.status)
+				_, _ = io.WriteString(w, tt.response)
+			})
+
+			got, err := tt.call(c.Incidents())
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package pulsetic
 
 import (
+	"slices"
+
 	"github.com/clevyr/pulsetic-operator/internal/pulsetic/pulsetictypes"
 )
 
@@ -11,12 +13,12 @@ type Monitor struct {
 	URL                       string                      `json:"url"`
 	Status                    string                      `json:"status"`
 	SSLCertificateState       string                      `json:"ssl_certificate_state"`
-	SSLCheck                  IntBool                     `json:"ssl_check"`
+	SSLCheck                  *IntBool                    `json:"ssl_check"`
 	IP                        string                      `json:"ip"`
 	Latitude                  float64                     `json:"latitude"`
 	Longitude                 float64                     `json:"longitude"`
 	IsRunning                 bool                        `json:"is_running"`
-	IsNegative                int                         `json:"is_negative"`
+	IsNegative                *IntBool                    `json:"is_negative"`
 	UptimeCheckFrequency      int                         `json:"uptime_check_frequency"`
 	OfflineNotificationDelay  int                         `json:"offline_notification_delay"`
 	RequestType               pulsetictypes.RequestType   `json:"request_type"`
@@ -35,7 +37,7 @@ type Monitor struct {
 	SnapshotsAverageUptime    string                      `json:"snapshots_average_uptime"`
 	Uptime                    float64                     `json:"uptime"`
 	ResponseTime              float64                     `json:"response_time"`
-	LighthouseAuditEnabled    bool                        `json:"lighthouse_audit_enabled"`
+	LighthouseAuditEnabled    *IntBool                    `json:"lighthouse_audit_enabled"`
 	MobilePerformanceScore    string                      `json:"mobile_performance_score"`
 	MobileSEOScore            string                      `json:"mobile_seo_score"`
 	MobilePWAScore            string                      `json:"mobile_pwa_score"`
@@ -62,6 +64,28 @@ type Monitor struct {
 	Nodes                     []Node                      `json:"nodes"`
 }
 
+// MatchesURL returns true if the monitor checks the given URL, ignoring a trailing slash.
+func (m Monitor) MatchesURL(url string) bool {
+	return m.URL == url || m.URL+"/" == url
+}
+
+// ActiveNodeIDs returns the sorted IDs of the nodes the monitor runs checks from.
+func (m Monitor) ActiveNodeIDs() []int64 {
+	var ids []int64
+	for _, node := range m.Nodes {
+		if node.Active {
+			ids = append(ids, node.ID)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+const (
+	StatusOnline  = "online"
+	StatusOffline = "offline"
+)
+
 type FormParam struct {
 	Name  string `json:"name"`
 	Value string `json:"value"`
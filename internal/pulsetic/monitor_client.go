@@ -125,6 +125,9 @@ func (m MonitorClient) FindByID(ctx context.Context, id int64) (Monitor, error)
 
 	res, err := m.client.Do(ctx, http.MethodGet, u, nil)
 	if err != nil {
+		if IsNotFound(err) {
+			return Monitor{}, ErrMonitorNotFound
+		}
 		return Monitor{}, err
 	}
 	defer consumeAndClose(res.Body)
@@ -140,7 +143,7 @@ func (m MonitorClient) FindByID(ctx context.Context, id int64) (Monitor, error)
 
 func (m MonitorClient) FindByURL(ctx context.Context, url string) (Monitor, error) {
 	for monitor, err := range m.List(ctx) {
-		if err != nil || monitor.URL == url || monitor.URL+"/" == url {
+		if err != nil || monitor.MatchesURL(url) {
 			return monitor, err
 		}
 	}
@@ -181,3 +184,46 @@ func (m MonitorClient) Delete(ctx context.Context, id int64) error {
 	defer consumeAndClose(res.Body)
 	return nil
 }
+
+// Pause stops checks for a monitor.
+func (m MonitorClient) Pause(ctx context.Context, id int64) error {
+	return m.action(ctx, id, "stop")
+}
+
+// Resume starts checks for a paused monitor.
+func (m MonitorClient) Resume(ctx context.Context, id int64) error {
+	return m.action(ctx, id, "start")
+}
+
+func (m MonitorClient) action(ctx context.Context, id int64, action string) error {
+	p := path.Join(endpointMonitors, strconv.FormatInt(id, 10), action)
+	res, err := m.client.Do(ctx, http.MethodPost, p, nil)
+	if err != nil {
+		return err
+	}
+	defer consumeAndClose(res.Body)
+	return nil
+}
+
+type SetContactsRequest struct {
+	Contacts []int64 `json:"contacts"`
+}
+
+// SetContacts replaces the contacts that are alerted for a monitor.
+func (m MonitorClient) SetContacts(ctx context.Context, id int64, contacts []int64) error {
+	if contacts == nil {
+		contacts = []int64{}
+	}
+	b, err := json.Marshal(SetContactsRequest{Contacts: contacts})
+	if err != nil {
+		return err
+	}
+
+	p := path.Join(endpointMonitors, strconv.FormatInt(id, 10), endpointContacts)
+	res, err := m.client.Do(ctx, http.MethodPut, p, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	defer consumeAndClose(res.Body)
+	return nil
+}
@@ -1,6 +1,10 @@
 package pulsetic
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
 	"strings"
 )
 
@@ -9,7 +13,11 @@ type MonitorEditParams struct {
 	Name                     string   `json:"name,omitzero"`
 	UptimeCheckFrequency     int      `json:"uptime_check_frequency,string,omitzero"`
 	OfflineNotificationDelay int      `json:"offline_notification_delay,string,omitzero"`
-	SSLCheck                 IntBool  `json:"ssl_check,omitzero"`
+	SSLCheck                 *IntBool `json:"ssl_check,omitzero"`
+	IsNegative               *IntBool `json:"is_negative,omitzero"`
+	TCPPorts                 string   `json:"tcp_ports,omitzero"`
+	LighthouseAuditEnabled   *IntBool `json:"lighthouse_audit_enabled,omitzero"`
+	Nodes                    []int64  `json:"nodes,omitzero"`
 	Request                  Request  `json:"request,omitzero"`
 	Response                 Response `json:"response,omitzero"`
 }
@@ -26,30 +34,81 @@ type Request struct {
 }
 
 type Response struct {
-	Body    string   `json:"body,omitzero"`
-	Headers []Header `json:"headers,omitzero"`
+	Body         string   `json:"body,omitzero"`
+	Headers      []Header `json:"headers,omitzero"`
+	ExpectedCode string   `json:"expected_code,omitzero"`
 }
 
+const (
+	BodyTypeRaw        = "raw"
+	BodyTypeJSON       = "json"
+	BodyTypeFormParams = "form_params"
+)
+
 func (m Monitor) EditParams() MonitorEditParams {
-	return MonitorEditParams{
+	params := MonitorEditParams{
 		URL:                      m.URL,
 		Name:                     m.Name,
 		UptimeCheckFrequency:     m.UptimeCheckFrequency,
 		OfflineNotificationDelay: m.OfflineNotificationDelay,
 		SSLCheck:                 m.SSLCheck,
+		IsNegative:               m.IsNegative,
+		TCPPorts:                 m.TCPPorts,
+		LighthouseAuditEnabled:   m.LighthouseAuditEnabled,
+		Nodes:                    m.ActiveNodeIDs(),
 		Request: Request{
-			Type:           strings.ToLower(m.RequestType.String()),
 			BodyType:       m.RequestBodyType,
 			BodyRaw:        m.RequestBodyRaw,
 			BodyJSON:       m.RequestBodyJSON,
 			BodyFormParams: m.RequestBodyFormParams,
-			Method:         strings.ToLower(m.RequestMethod.String()),
 			Headers:        m.RequestHeaders,
 			Timeout:        m.RequestTimeout,
 		},
 		Response: Response{
-			Body:    m.ResponseBody,
-			Headers: m.ResponseHeaders,
+			Body:         m.ResponseBody,
+			Headers:      m.ResponseHeaders,
+			ExpectedCode: m.ResponseExpectedCode,
 		},
 	}
+	if m.RequestType.IsARequestType() {
+		params.Request.Type = strings.ToLower(m.RequestType.String())
+	}
+	if m.RequestMethod.IsARequestMethod() {
+		params.Request.Method = strings.ToLower(m.RequestMethod.String())
+	}
+	return params
+}
+
+// Diff returns the JSON paths of fields in p that differ from actual.
+// Fields that p would omit from its JSON encoding are not compared.
+func (p MonitorEditParams) Diff(actual MonitorEditParams) []string {
+	return diffFields("", reflect.ValueOf(p), reflect.ValueOf(actual))
+}
+
+func diffFields(prefix string, want, got reflect.Value) []string {
+	var diff []string
+	for i := range want.NumField() {
+		name, opts, _ := strings.Cut(want.Type().Field(i).Tag.Get("json"), ",")
+		w, g := want.Field(i), got.Field(i)
+		if w.IsZero() && strings.Contains(opts, "omitzero") {
+			continue
+		}
+
+		if w.Kind() == reflect.Struct {
+			diff = append(diff, diffFields(prefix+name+".", w, g)...)
+		} else if !reflect.DeepEqual(w.Interface(), g.Interface()) {
+			diff = append(diff, prefix+name)
+		}
+	}
+	return diff
+}
+
+// Hash returns a digest of the params, used to detect when the desired state has changed.
+func (p MonitorEditParams) Hash() string {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
 }
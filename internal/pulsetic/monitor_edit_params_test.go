@@ -0,0 +1,89 @@
+package pulsetic
+
+import (
+	"testing"
+
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic/pulsetictypes"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+)
+
+func TestMonitorEditParams_Diff(t *testing.T) {
+	remote := Monitor{
+		Name:                   "Example",
+		URL:                    "https://example.com",
+		UptimeCheckFrequency:   60,
+		RequestType:            pulsetictypes.RequestTypeHTTP,
+		RequestMethod:          pulsetictypes.MethodGET,
+		RequestTimeout:         5,
+		RequestHeaders:         []Header{{Name: "A", Value: "B"}},
+		SSLCheck:               ptr.To[IntBool](true),
+		IsNegative:             ptr.To[IntBool](true),
+		LighthouseAuditEnabled: ptr.To[IntBool](true),
+	}
+
+	tests := []struct {
+		name    string
+		desired Monitor
+		want    []string
+	}{
+		{"equal", remote, nil},
+		{"unset fields ignored", Monitor{Name: "Example", URL: "https://example.com"}, nil},
+		{
+			"changed fields",
+			Monitor{Name: "Example", URL: "https://example.com", UptimeCheckFrequency: 30, RequestTimeout: 10},
+			[]string{"uptime_check_frequency", "request.timeout"},
+		},
+		{
+			"changed header",
+			Monitor{Name: "Example", URL: "https://example.com", RequestHeaders: []Header{{Name: "A", Value: "C"}}},
+			[]string{"request.headers"},
+		},
+		{
+			"explicit false bool fields compared",
+			Monitor{
+				Name:                   "Example",
+				URL:                    "https://example.com",
+				SSLCheck:               ptr.To[IntBool](false),
+				IsNegative:             ptr.To[IntBool](false),
+				LighthouseAuditEnabled: ptr.To[IntBool](false),
+			},
+			[]string{"ssl_check", "is_negative", "lighthouse_audit_enabled"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.desired.EditParams().Diff(remote.EditParams()))
+		})
+	}
+}
+
+func TestMonitor_EditParams(t *testing.T) {
+	monitor := Monitor{
+		Name:                 "Example",
+		URL:                  "https://example.com",
+		RequestType:          pulsetictypes.RequestTypeHTTP,
+		RequestMethod:        pulsetictypes.MethodPOST,
+		RequestHeaders:       []Header{{Name: "Authorization", Value: "Bearer token"}},
+		RequestBodyType:      BodyTypeJSON,
+		RequestBodyJSON:      `{"a":"b"}`,
+		ResponseBody:         "ok",
+		ResponseExpectedCode: "204",
+	}
+
+	assert.Equal(t, MonitorEditParams{
+		Name: "Example",
+		URL:  "https://example.com",
+		Request: Request{
+			Type:     "http",
+			Method:   "post",
+			Headers:  []Header{{Name: "Authorization", Value: "Bearer token"}},
+			BodyType: BodyTypeJSON,
+			BodyJSON: `{"a":"b"}`,
+		},
+		Response: Response{
+			Body:         "ok",
+			ExpectedCode: "204",
+		},
+	}, monitor.EditParams())
+}
@@ -0,0 +1,33 @@
+//nolint:bodyclose
+package pulsetic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+type NodeClient struct {
+	client Client
+}
+
+const endpointNodes = "nodes"
+
+type NodesResponse struct {
+	Data []Node `json:"data"`
+}
+
+// List returns the check nodes that monitors can run from.
+func (n NodeClient) List(ctx context.Context) ([]Node, error) {
+	res, err := n.client.Do(ctx, http.MethodGet, endpointNodes, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer consumeAndClose(res.Body)
+
+	var parsed NodesResponse
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return nil, err
+	}
+	return parsed.Data, nil
+}
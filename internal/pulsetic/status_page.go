@@ -0,0 +1,43 @@
+package pulsetic
+
+import "slices"
+
+type StatusPage struct {
+	ID           int64               `json:"id"`
+	Title        string              `json:"title"`
+	URL          string              `json:"url"`
+	CustomDomain string              `json:"custom_domain"`
+	LogoURL      string              `json:"logo_url"`
+	Sections     []StatusPageSection `json:"sections"`
+}
+
+type StatusPageSection struct {
+	Name     string  `json:"name"`
+	Monitors []int64 `json:"monitors"`
+}
+
+type StatusPageEditParams struct {
+	Title        string              `json:"title"`
+	CustomDomain string              `json:"custom_domain,omitzero"`
+	LogoURL      string              `json:"logo_url,omitzero"`
+	Sections     []StatusPageSection `json:"sections"`
+}
+
+func (s StatusPage) EditParams() StatusPageEditParams {
+	return StatusPageEditParams{
+		Title:        s.Title,
+		CustomDomain: s.CustomDomain,
+		LogoURL:      s.LogoURL,
+		Sections:     s.Sections,
+	}
+}
+
+// Equal reports whether the params would leave a status page unchanged.
+func (p StatusPageEditParams) Equal(other StatusPageEditParams) bool {
+	return p.Title == other.Title &&
+		p.CustomDomain == other.CustomDomain &&
+		p.LogoURL == other.LogoURL &&
+		slices.EqualFunc(p.Sections, other.Sections, func(a, b StatusPageSection) bool {
+			return a.Name == b.Name && slices.Equal(a.Monitors, b.Monitors)
+		})
+}
@@ -0,0 +1,84 @@
+//nolint:bodyclose
+package pulsetic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"path"
+	"strconv"
+)
+
+var ErrStatusPageNotFound = errors.New("status page not found")
+
+type StatusPageClient struct {
+	client Client
+}
+
+const endpointStatusPages = "status-pages"
+
+type StatusPageResponse struct {
+	Data StatusPage `json:"data"`
+}
+
+func (s StatusPageClient) Create(ctx context.Context, page StatusPage) (StatusPage, error) {
+	return s.send(ctx, http.MethodPost, endpointStatusPages, page)
+}
+
+func (s StatusPageClient) FindByID(ctx context.Context, id int64) (StatusPage, error) {
+	u := path.Join(endpointStatusPages, strconv.FormatInt(id, 10))
+
+	res, err := s.client.Do(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		if IsNotFound(err) {
+			return StatusPage{}, ErrStatusPageNotFound
+		}
+		return StatusPage{}, err
+	}
+	defer consumeAndClose(res.Body)
+
+	var parsed StatusPageResponse
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return StatusPage{}, err
+	}
+	if parsed.Data.ID == 0 {
+		return StatusPage{}, ErrStatusPageNotFound
+	}
+	return parsed.Data, nil
+}
+
+func (s StatusPageClient) Update(ctx context.Context, id int64, page StatusPage) (StatusPage, error) {
+	u := path.Join(endpointStatusPages, strconv.FormatInt(id, 10))
+	return s.send(ctx, http.MethodPut, u, page)
+}
+
+func (s StatusPageClient) Delete(ctx context.Context, id int64) error {
+	u := path.Join(endpointStatusPages, strconv.FormatInt(id, 10))
+	res, err := s.client.Do(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return err
+	}
+	defer consumeAndClose(res.Body)
+	return nil
+}
+
+func (s StatusPageClient) send(ctx context.Context, method, endpoint string, page StatusPage) (StatusPage, error) {
+	b, err := json.Marshal(page.EditParams())
+	if err != nil {
+		return StatusPage{}, err
+	}
+
+	res, err := s.client.Do(ctx, method, endpoint, bytes.NewReader(b))
+	if err != nil {
+		return StatusPage{}, err
+	}
+	defer consumeAndClose(res.Body)
+
+	var parsed StatusPageResponse
+	if err := json.NewDecoder(res.Body).Decode(&parsed); err != nil {
+		return StatusPage{}, err
+	}
+	return parsed.Data, nil
+}
@@ -0,0 +1,99 @@
+package pulsetic
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusPage_EditParams(t *testing.T) {
+	page := StatusPage{
+		ID:       1,
+		Title:    "Example",
+		URL:      "https://status.example.com",
+		Sections: []StatusPageSection{{Name: "API", Monitors: []int64{1, 2}}},
+	}
+
+	b, err := json.Marshal(page.EditParams())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"title":"Example","sections":[{"name":"API","monitors":[1,2]}]}`, string(b))
+}
+
+func TestStatusPageClient(t *testing.T) {
+	tests := []struct {
+		name     string
+		call     func(c StatusPageClient) (StatusPage, error)
+		method   string
+		path     string
+		status   int
+		response string
+		want     StatusPage
+		wantErr  error
+	}{
+		{
+			"create",
+			func(c StatusPageClient) (StatusPage, error) {
+				return c.Create(t.Context(), StatusPage{Title: "Example"})
+			},
+			http.MethodPost, "/status-pages", http.StatusOK,
+			`{"data":{"id":1,"title":"Example"}}`,
+			StatusPage{ID: 1, Title: "Example"}, nil,
+		},
+		{
+			"update",
+			func(c StatusPageClient) (StatusPage, error) {
+				return c.Update(t.Context(), 1, StatusPage{Title: "Example"})
+			},
+			http.MethodPut, "/status-pages/1", http.StatusOK,
+			`{"data":{"id":1,"title":"Example"}}`,
+			StatusPage{ID: 1, Title: "Example"}, nil,
+		},
+		{
+			"find",
+			func(c StatusPageClient) (StatusPage, error) { return c.FindByID(t.Context(), 1) },
+			http.MethodGet, "/status-pages/1", http.StatusOK,
+			`{"data":{"id":1,"title":"Example","url":"https://status.example.com"}}`,
+			StatusPage{ID: 1, Title: "Example", URL: "https://status.example.com"}, nil,
+		},
+		{
+			"find not found",
+			func(c StatusPageClient) (StatusPage, error) { return c.FindByID(t.Context(), 1) },
+			http.MethodGet, "/status-pages/1", http.StatusNotFound,
+			`{"message":"Not found"}`,
+			StatusPage{}, ErrStatusPageNotFound,
+		},
+		{
+			"find empty",
+			func(c StatusPageClient) (StatusPage, error) { return c.FindByID(t.Context(), 1) },
+			http.MethodGet, "/status-pages/1", http.StatusOK,
+			`{"data":null}`,
+			StatusPage{}, ErrStatusPageNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.method, r.Method)
+				assert.Equal(t, tt.path, r.URL.Path)
+				if r.Method != http.MethodGet {
+					b, _ := io.ReadAll(r.Body)
+					assert.JSONEq(t, `{"title":"Example","sections":null}`, string(b))
+				}
+				w.WriteHeader(tt.status)
+				_, _ = io.WriteString(w, tt.response)
+			})
+
+			got, err := tt.call(c.StatusPages())
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
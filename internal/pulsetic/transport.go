@@ -0,0 +1,178 @@
+package pulsetic
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Options configures how requests are sent to the Pulsetic API.
+type Options struct {
+	// Timeout is the maximum duration of a single attempt, including reading the response body.
+	Timeout time.Duration
+	// MaxRetries is the number of times a failed request is retried.
+	MaxRetries int
+	// RetryBaseDelay is the delay before the first retry. It doubles with each attempt.
+	RetryBaseDelay time.Duration
+	// MaxRetryDelay caps the delay between attempts, including delays requested by the API.
+	MaxRetryDelay time.Duration
+	// RateLimit is the number of requests per second allowed for each API key. Zero disables rate limiting.
+	RateLimit float64
+	// Burst is the number of requests that may be sent at once before RateLimit applies.
+	Burst int
+}
+
+// DefaultOptions are used by clients created with NewClient.
+// They must be set before the first client is created.
+//
+//nolint:gochecknoglobals
+var DefaultOptions = Options{
+	Timeout:        30 * time.Second,
+	MaxRetries:     3,
+	RetryBaseDelay: time.Second,
+	MaxRetryDelay:  time.Minute,
+	RateLimit:      2,
+	Burst:          5,
+}
+
+// limiterIdleTimeout is how long a rate limiter may go unused before it is evicted.
+// It is long enough for an idle limiter to refill, so a new one starts from the same state.
+const limiterIdleTimeout = 10 * time.Minute
+
+// limiters holds a sharedLimiter for each API key, keyed by the key's SHA-256 hash
+// so the keys themselves aren't kept in memory.
+//
+//nolint:gochecknoglobals
+var limiters sync.Map
+
+// sharedLimiter is a rate limiter that records when it was last used.
+type sharedLimiter struct {
+	*rate.Limiter
+	lastUsed atomic.Int64
+}
+
+// Wait blocks until the limiter allows a request.
+func (l *sharedLimiter) Wait(ctx context.Context) error {
+	l.lastUsed.Store(time.Now().UnixNano())
+	return l.Limiter.Wait(ctx)
+}
+
+// limiterFor returns the rate limiter for an API key.
+// Limiters are shared by every client using the key, so all reconcilers draw from the same budget.
+// Limiters that have been idle for longer than limiterIdleTimeout are evicted.
+func limiterFor(apiKey string, opts Options, now time.Time) *sharedLimiter {
+	limiters.Range(func(k, v any) bool {
+		l := v.(*sharedLimiter) //nolint:errcheck
+		if now.Sub(time.Unix(0, l.lastUsed.Load())) > limiterIdleTimeout {
+			limiters.CompareAndDelete(k, l)
+		}
+		return true
+	})
+
+	sum := sha256.Sum256([]byte(apiKey))
+	key := hex.EncodeToString(sum[:])
+	if v, ok := limiters.Load(key); ok {
+		l := v.(*sharedLimiter) //nolint:errcheck
+		l.lastUsed.Store(now.UnixNano())
+		return l
+	}
+
+	limit := rate.Inf
+	if opts.RateLimit > 0 {
+		limit = rate.Limit(opts.RateLimit)
+	}
+	l := &sharedLimiter{Limiter: rate.NewLimiter(limit, max(opts.Burst, 1))}
+	l.lastUsed.Store(now.UnixNano())
+	v, _ := limiters.LoadOrStore(key, l)
+	return v.(*sharedLimiter) //nolint:errcheck
+}
+
+// shouldRetry returns true if a request should be retried after the given response or error.
+// Requests that are not idempotent are only retried when the API refused them with a 429,
+// since a 5xx or network error does not tell whether the request was applied.
+func shouldRetry(method string, res *http.Response, err error) bool {
+	switch {
+	case err != nil:
+		return isIdempotent(method)
+	case res.StatusCode == http.StatusTooManyRequests:
+		return true
+	case res.StatusCode >= http.StatusInternalServerError:
+		return isIdempotent(method)
+	default:
+		return false
+	}
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryDelay returns how long to wait before the next attempt.
+// The Retry-After and X-RateLimit-Reset headers are honored when present,
+// otherwise the delay grows exponentially with each attempt.
+func retryDelay(opts Options, attempt int, res *http.Response, now time.Time) time.Duration {
+	limit := opts.MaxRetryDelay
+	if limit <= 0 {
+		limit = math.MaxInt64
+	}
+	delay := opts.RetryBaseDelay
+	// Stop doubling at the limit so that large attempts can't overflow.
+	if delay > 0 {
+		if attempt >= 63 || delay > limit>>attempt {
+			delay = limit
+		} else {
+			delay <<= attempt
+		}
+	}
+	// Negative values and dates in the past fall back to the exponential delay.
+	if res != nil {
+		if d, ok := headerDelay(res.Header, now); ok && d > 0 {
+			delay = d
+		}
+	}
+	return min(max(delay, 0), limit)
+}
+
+// maxDelaySeconds is the largest number of seconds a time.Duration can hold.
+const maxDelaySeconds = math.MaxInt64 / int64(time.Second)
+
+// headerDelay returns the delay requested by the Retry-After or X-RateLimit-Reset header.
+// Durations too large to represent saturate at math.MaxInt64 or math.MinInt64.
+func headerDelay(h http.Header, now time.Time) (time.Duration, bool) {
+	if v := h.Get("Retry-After"); v != "" {
+		if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+			switch {
+			case secs > maxDelaySeconds:
+				return math.MaxInt64, true
+			case secs < -maxDelaySeconds:
+				return math.MinInt64, true
+			}
+			return time.Duration(secs) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return t.Sub(now), true
+		}
+	}
+	if v := h.Get("X-RateLimit-Reset"); v != "" {
+		if unix, err := strconv.ParseInt(v, 10, 64); err == nil {
+			if unix > now.Unix()+maxDelaySeconds {
+				return math.MaxInt64, true
+			}
+			return time.Unix(unix, 0).Sub(now), true
+		}
+	}
+	return 0, false
+}
@@ -2,6 +2,7 @@ package pulsetic
 
 import (
 	"bytes"
+	"errors"
 	"io"
 	"net/http"
 	"strconv"
@@ -35,6 +36,20 @@ func (e ResponseError) Error() string {
 	return buf.String()
 }
 
+// IsNotFound returns true if err is a 404 response from the Pulsetic API.
+func IsNotFound(err error) bool {
+	var resErr ResponseError
+	return errors.As(err, &resErr) && resErr.Response != nil && resErr.Response.StatusCode == http.StatusNotFound
+}
+
+// IsValidationError returns true if err is a 422 response from the Pulsetic API.
+// The field-level errors are available in ResponseError.Errors.
+func IsValidationError(err error) bool {
+	var resErr ResponseError
+	return errors.As(err, &resErr) && resErr.Response != nil &&
+		resErr.Response.StatusCode == http.StatusUnprocessableEntity
+}
+
 func consumeAndClose(r io.ReadCloser) {
 	_, _ = io.Copy(io.Discard, r)
 	_ = r.Close()
@@ -59,6 +74,13 @@ func (i *IntBool) UnmarshalJSON(b []byte) error {
 
 type UnixOrTime time.Time
 
+func (t UnixOrTime) MarshalJSON() ([]byte, error) {
+	if time.Time(t).IsZero() {
+		return []byte("null"), nil
+	}
+	return strconv.AppendInt(nil, time.Time(t).Unix(), 10), nil
+}
+
 func (t *UnixOrTime) UnmarshalJSON(b []byte) error {
 	if bytes.Equal(b, []byte("null")) {
 		*t = UnixOrTime(time.Time{})
@@ -54,3 +54,17 @@ func TestUnixOrTime_UnmarshalJSON(t *testing.T) {
 		assert.True(t, tt.want.Equal(time.Time(v)))
 	}
 }
+
+func TestUnixOrTime_MarshalJSON(t *testing.T) {
+	now := time.Unix(time.Now().Unix(), 0)
+
+	b, err := UnixOrTime(now).MarshalJSON()
+	require.NoError(t, err)
+	var v UnixOrTime
+	require.NoError(t, v.UnmarshalJSON(b))
+	assert.True(t, now.Equal(time.Time(v)))
+
+	b, err = UnixOrTime{}.MarshalJSON()
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(b))
+}
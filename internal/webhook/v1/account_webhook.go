@@ -0,0 +1,105 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupAccountWebhookWithManager registers the webhook for Account in the manager.
+func SetupAccountWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&pulseticv1.Account{}).
+		WithValidator(&AccountCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-pulsetic-clevyr-com-v1-account,mutating=false,failurePolicy=fail,sideEffects=None,groups=pulsetic.clevyr.com,resources=accounts,verbs=create;update,versions=v1,name=vaccount-v1.kb.io,admissionReviewVersions=v1
+
+// AccountCustomValidator validates Accounts when they are created or updated.
+type AccountCustomValidator struct {
+	Client client.Client
+}
+
+var _ webhook.CustomValidator = &AccountCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (v *AccountCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	account, ok := obj.(*pulseticv1.Account)
+	if !ok {
+		return nil, fmt.Errorf("expected an Account object but got %T", obj)
+	}
+	return nil, v.validate(ctx, account)
+}
+
+// ValidateUpdate implements webhook.CustomValidator.
+func (v *AccountCustomValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	account, ok := newObj.(*pulseticv1.Account)
+	if !ok {
+		return nil, fmt.Errorf("expected an Account object for the newObj but got %T", newObj)
+	}
+	if !account.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+	return nil, v.validate(ctx, account)
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (v *AccountCustomValidator) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *AccountCustomValidator) validate(ctx context.Context, account *pulseticv1.Account) error {
+	specPath := field.NewPath("spec")
+	var allErrs field.ErrorList
+
+	if selector := account.Spec.AllowedNamespaces; selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(selector); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("allowedNamespaces"), selector, err.Error()))
+		}
+	}
+
+	if account.Spec.IsDefault {
+		list := &pulseticv1.AccountList{}
+		if err := v.Client.List(ctx, list); err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		for _, other := range list.Items {
+			if other.Spec.IsDefault && other.Name != account.Name {
+				allErrs = append(allErrs, field.Forbidden(specPath.Child("isDefault"),
+					fmt.Sprintf("account %q is already the default", other.Name),
+				))
+				break
+			}
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(pulseticv1.GroupVersion.WithKind("Account").GroupKind(), account.Name, allErrs)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestAccountCustomValidator_ValidateCreate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, pulseticv1.AddToScheme(scheme))
+
+	existing := &pulseticv1.Account{
+		ObjectMeta: metav1.ObjectMeta{Name: "existing"},
+		Spec:       pulseticv1.AccountSpec{IsDefault: true},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing).Build()
+	v := &AccountCustomValidator{Client: c}
+
+	account := &pulseticv1.Account{ObjectMeta: metav1.ObjectMeta{Name: "example"}}
+	_, err := v.ValidateCreate(t.Context(), account)
+	require.NoError(t, err)
+
+	account.Spec.IsDefault = true
+	_, err = v.ValidateCreate(t.Context(), account)
+	require.Error(t, err)
+	assert.True(t, apierrors.IsInvalid(err))
+	assert.Contains(t, err.Error(), "spec.isDefault")
+
+	_, err = v.ValidateUpdate(t.Context(), existing, existing)
+	require.NoError(t, err)
+
+	account.Spec.IsDefault = false
+	account.Spec.AllowedNamespaces = &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Bogus"}},
+	}
+	_, err = v.ValidateCreate(t.Context(), account)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.allowedNamespaces")
+}
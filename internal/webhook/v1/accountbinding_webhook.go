@@ -0,0 +1,102 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupAccountBindingWebhookWithManager registers the webhook for AccountBinding in the manager.
+func SetupAccountBindingWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&pulseticv1.AccountBinding{}).
+		WithValidator(&AccountBindingCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-pulsetic-clevyr-com-v1-accountbinding,mutating=false,failurePolicy=fail,sideEffects=None,groups=pulsetic.clevyr.com,resources=accountbindings,verbs=create;update,versions=v1,name=vaccountbinding-v1.kb.io,admissionReviewVersions=v1
+
+// AccountBindingCustomValidator validates AccountBindings when they are created or updated.
+type AccountBindingCustomValidator struct {
+	Client client.Client
+}
+
+var _ webhook.CustomValidator = &AccountBindingCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (v *AccountBindingCustomValidator) ValidateCreate(
+	ctx context.Context,
+	obj runtime.Object,
+) (admission.Warnings, error) {
+	binding, ok := obj.(*pulseticv1.AccountBinding)
+	if !ok {
+		return nil, fmt.Errorf("expected an AccountBinding object but got %T", obj)
+	}
+	return nil, v.validate(ctx, binding)
+}
+
+// ValidateUpdate implements webhook.CustomValidator.
+func (v *AccountBindingCustomValidator) ValidateUpdate(
+	ctx context.Context,
+	_, newObj runtime.Object,
+) (admission.Warnings, error) {
+	binding, ok := newObj.(*pulseticv1.AccountBinding)
+	if !ok {
+		return nil, fmt.Errorf("expected an AccountBinding object for the newObj but got %T", newObj)
+	}
+	if !binding.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+	return nil, v.validate(ctx, binding)
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (v *AccountBindingCustomValidator) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *AccountBindingCustomValidator) validate(ctx context.Context, binding *pulseticv1.AccountBinding) error {
+	if !binding.Spec.IsDefault {
+		return nil
+	}
+
+	list := &pulseticv1.AccountBindingList{}
+	if err := v.Client.List(ctx, list, client.InNamespace(binding.Namespace)); err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	for _, other := range list.Items {
+		if other.Spec.IsDefault && other.Name != binding.Name {
+			return apierrors.NewInvalid(
+				pulseticv1.GroupVersion.WithKind("AccountBinding").GroupKind(),
+				binding.Name,
+				field.ErrorList{field.Forbidden(field.NewPath("spec", "isDefault"),
+					fmt.Sprintf("account binding %q is already the default in this namespace", other.Name),
+				)},
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/clevyr/pulsetic-operator/internal/controller"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic/pulsetictypes"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupMonitorWebhookWithManager registers the webhook for Monitor in the manager.
+func SetupMonitorWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&pulseticv1.Monitor{}).
+		WithValidator(&MonitorCustomValidator{Client: mgr.GetClient()}).
+		WithDefaulter(&MonitorCustomDefaulter{Client: mgr.GetClient()}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-pulsetic-clevyr-com-v1-monitor,mutating=true,failurePolicy=fail,sideEffects=None,groups=pulsetic.clevyr.com,resources=monitors,verbs=create;update,versions=v1,name=mmonitor-v1.kb.io,admissionReviewVersions=v1
+
+// MonitorCustomDefaulter pins Monitors to an Account when they are created or updated,
+// so that a later change to the default Account does not move existing monitors.
+type MonitorCustomDefaulter struct {
+	Client client.Client
+}
+
+var _ webhook.CustomDefaulter = &MonitorCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter.
+func (d *MonitorCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	monitor, ok := obj.(*pulseticv1.Monitor)
+	if !ok {
+		return fmt.Errorf("expected a Monitor object but got %T", obj)
+	}
+
+	if monitor.Spec.Account.Name != "" || !monitor.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	account := &pulseticv1.Account{}
+	if err := controller.GetAccount(ctx, d.Client, account, monitor.Namespace, ""); err != nil {
+		// Leave the account unset so that the validating webhook reports why it could not be resolved.
+		return nil //nolint:nilerr
+	}
+	monitor.Spec.Account.Name = account.Name
+	return nil
+}
+
+//+kubebuilder:webhook:path=/validate-pulsetic-clevyr-com-v1-monitor,mutating=false,failurePolicy=fail,sideEffects=None,groups=pulsetic.clevyr.com,resources=monitors,verbs=create;update,versions=v1,name=vmonitor-v1.kb.io,admissionReviewVersions=v1
+
+// MonitorCustomValidator validates Monitors when they are created or updated.
+type MonitorCustomValidator struct {
+	Client client.Client
+}
+
+var _ webhook.CustomValidator = &MonitorCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (v *MonitorCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	monitor, ok := obj.(*pulseticv1.Monitor)
+	if !ok {
+		return nil, fmt.Errorf("expected a Monitor object but got %T", obj)
+	}
+	return v.validate(ctx, monitor, true)
+}
+
+// ValidateUpdate implements webhook.CustomValidator.
+func (v *MonitorCustomValidator) ValidateUpdate(
+	ctx context.Context,
+	oldObj, newObj runtime.Object,
+) (admission.Warnings, error) {
+	oldMonitor, ok := oldObj.(*pulseticv1.Monitor)
+	if !ok {
+		return nil, fmt.Errorf("expected a Monitor object for the oldObj but got %T", oldObj)
+	}
+	monitor, ok := newObj.(*pulseticv1.Monitor)
+	if !ok {
+		return nil, fmt.Errorf("expected a Monitor object for the newObj but got %T", newObj)
+	}
+	// Metadata-only updates, like adding or removing the finalizer, must not be blocked
+	// by a spec that was accepted before.
+	if !monitor.DeletionTimestamp.IsZero() || equality.Semantic.DeepEqual(oldMonitor.Spec, monitor.Spec) {
+		return nil, nil
+	}
+	// The Account is only checked when it changes, so deleting an Account or disallowing a namespace
+	// doesn't block updates to the Monitors that already use it.
+	return v.validate(ctx, monitor, oldMonitor.Spec.Account != monitor.Spec.Account)
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (v *MonitorCustomValidator) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *MonitorCustomValidator) validate(
+	ctx context.Context,
+	monitor *pulseticv1.Monitor,
+	checkAccount bool,
+) (admission.Warnings, error) {
+	specPath := field.NewPath("spec")
+	allErrs := validateMonitorValues(specPath.Child("monitor"), monitor.Spec.Monitor)
+
+	var warnings admission.Warnings
+	defaults := monitor.Spec.Monitor.MonitorDefaults
+	for _, snapped := range defaults.Snap() {
+		if monitor.Spec.UnsupportedValuePolicy == pulseticv1.UnsupportedValuePolicyReject {
+			allErrs = append(allErrs, field.Invalid(
+				specPath.Child("monitor", snapped.Field), snapped.From.String(), snapped.String(),
+			))
+		} else {
+			warnings = append(warnings, snapped.String())
+		}
+	}
+
+	if checkAccount {
+		allErrs = append(allErrs, v.validateAccount(ctx, specPath.Child("account", "name"), monitor)...)
+	}
+
+	if len(allErrs) == 0 {
+		return warnings, nil
+	}
+	return warnings, apierrors.NewInvalid(pulseticv1.GroupVersion.WithKind("Monitor").GroupKind(), monitor.Name, allErrs)
+}
+
+// validateAccount checks that the Monitor's Account exists and allows its namespace.
+func (v *MonitorCustomValidator) validateAccount(
+	ctx context.Context,
+	path *field.Path,
+	monitor *pulseticv1.Monitor,
+) field.ErrorList {
+	account := &pulseticv1.Account{}
+	err := controller.GetAccount(ctx, v.Client, account, monitor.Namespace, monitor.Spec.Account.Name)
+	switch {
+	case err == nil:
+		return nil
+	case apierrors.IsNotFound(err):
+		return field.ErrorList{field.NotFound(path, monitor.Spec.Account.Name)}
+	case errors.Is(err, controller.ErrNoDefaultAccount):
+		return field.ErrorList{field.Required(path, err.Error())}
+	default:
+		return field.ErrorList{field.Invalid(path, monitor.Spec.Account.Name, err.Error())}
+	}
+}
+
+// validateMonitorValues checks that the URL and type-specific fields are consistent with the monitor type.
+func validateMonitorValues(path *field.Path, values pulseticv1.MonitorValues) field.ErrorList {
+	var allErrs field.ErrorList
+
+	requestType := pulsetictypes.RequestTypeHTTP
+	if values.Type != nil {
+		requestType = *values.Type
+	}
+
+	urlPath := path.Child("url")
+	switch requestType {
+	case pulsetictypes.RequestTypeHTTP:
+		u, err := url.Parse(values.URL)
+		switch {
+		case err != nil:
+			allErrs = append(allErrs, field.Invalid(urlPath, values.URL, err.Error()))
+		case u.Scheme != "http" && u.Scheme != "https":
+			allErrs = append(allErrs, field.Invalid(urlPath, values.URL, "scheme must be http or https"))
+		case u.Host == "":
+			allErrs = append(allErrs, field.Invalid(urlPath, values.URL, "host is required"))
+		}
+	default:
+		if u, err := url.Parse("//" + values.URL); err != nil || u.Host != values.URL {
+			allErrs = append(allErrs, field.Invalid(urlPath, values.URL,
+				"must be a hostname or IP without a scheme or path when type is "+requestType.String(),
+			))
+		}
+	}
+
+	if requestType != pulsetictypes.RequestTypeTCP && len(values.Ports) != 0 {
+		allErrs = append(allErrs, field.Forbidden(path.Child("ports"), "only allowed when type is TCP"))
+	}
+
+	if requestType != pulsetictypes.RequestTypeHTTP {
+		if values.Request != nil {
+			allErrs = append(allErrs, field.Forbidden(path.Child("request"), "only allowed when type is HTTP"))
+		}
+		if values.Response != nil {
+			allErrs = append(allErrs, field.Forbidden(path.Child("response"), "only allowed when type is HTTP"))
+		}
+		if values.Lighthouse != nil && values.Lighthouse.Enabled {
+			allErrs = append(allErrs, field.Forbidden(path.Child("lighthouse"), "only allowed when type is HTTP"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	pulseticv1 "github.com/clevyr/pulsetic-operator/api/v1"
+	"github.com/clevyr/pulsetic-operator/internal/pulsetic/pulsetictypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_validateMonitorValues(t *testing.T) {
+	path := field.NewPath("spec", "monitor")
+
+	tests := []struct {
+		name   string
+		values pulseticv1.MonitorValues
+		want   []string
+	}{
+		{"http", pulseticv1.MonitorValues{URL: "https://example.com/health"}, nil},
+		{"http without scheme", pulseticv1.MonitorValues{URL: "example.com"}, []string{"spec.monitor.url"}},
+		{"http without host", pulseticv1.MonitorValues{URL: "https://"}, []string{"spec.monitor.url"}},
+		{"icmp", pulseticv1.MonitorValues{
+			URL:  "example.com",
+			Type: ptr.To(pulsetictypes.RequestTypeICMP),
+		}, nil},
+		{"icmp with path", pulseticv1.MonitorValues{
+			URL:  "example.com/health",
+			Type: ptr.To(pulsetictypes.RequestTypeICMP),
+		}, []string{"spec.monitor.url"}},
+		{"icmp with http fields", pulseticv1.MonitorValues{
+			URL:      "example.com",
+			Type:     ptr.To(pulsetictypes.RequestTypeICMP),
+			Ports:    []int32{443},
+			Request:  &pulseticv1.MonitorRequest{},
+			Response: &pulseticv1.MonitorResponse{},
+		}, []string{"spec.monitor.ports", "spec.monitor.request", "spec.monitor.response"}},
+		{"tcp", pulseticv1.MonitorValues{
+			URL:   "example.com",
+			Type:  ptr.To(pulsetictypes.RequestTypeTCP),
+			Ports: []int32{5432},
+		}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, err := range validateMonitorValues(path, tt.values) {
+				got = append(got, err.Field)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMonitorCustomValidator_ValidateCreate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, pulseticv1.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&pulseticv1.Account{ObjectMeta: metav1.ObjectMeta{Name: "example"}},
+	).Build()
+	v := &MonitorCustomValidator{Client: c}
+
+	monitor := &pulseticv1.Monitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "default"},
+		Spec: pulseticv1.MonitorSpec{
+			Account: corev1.LocalObjectReference{Name: "example"},
+			Monitor: pulseticv1.MonitorValues{URL: "https://example.com"},
+		},
+	}
+	_, err := v.ValidateCreate(t.Context(), monitor)
+	require.NoError(t, err)
+
+	monitor.Spec.Account.Name = "missing"
+	_, err = v.ValidateCreate(t.Context(), monitor)
+	require.Error(t, err)
+	assert.True(t, apierrors.IsInvalid(err))
+	assert.Contains(t, err.Error(), "spec.account.name")
+}
+
+func TestMonitorCustomValidator_ValidateUpdate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, pulseticv1.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&pulseticv1.Account{ObjectMeta: metav1.ObjectMeta{Name: "example"}},
+	).Build()
+	v := &MonitorCustomValidator{Client: c}
+
+	// The Account was deleted after the Monitor was created.
+	old := &pulseticv1.Monitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "default"},
+		Spec: pulseticv1.MonitorSpec{
+			Account: corev1.LocalObjectReference{Name: "deleted"},
+			Monitor: pulseticv1.MonitorValues{URL: "https://example.com"},
+		},
+	}
+
+	finalized := old.DeepCopy()
+	finalized.Finalizers = []string{"pulsetic.clevyr.com/finalizer"}
+	_, err := v.ValidateUpdate(t.Context(), old, finalized)
+	require.NoError(t, err, "metadata-only updates are allowed")
+
+	renamed := old.DeepCopy()
+	renamed.Spec.Monitor.Name = "Example"
+	_, err = v.ValidateUpdate(t.Context(), old, renamed)
+	require.NoError(t, err, "an unchanged account is not checked")
+
+	invalid := old.DeepCopy()
+	invalid.Spec.Monitor.URL = ""
+	_, err = v.ValidateUpdate(t.Context(), old, invalid)
+	require.Error(t, err, "spec changes are still validated")
+
+	moved := old.DeepCopy()
+	moved.Spec.Account.Name = "missing"
+	_, err = v.ValidateUpdate(t.Context(), old, moved)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.account.name")
+
+	moved.Spec.Account.Name = "example"
+	_, err = v.ValidateUpdate(t.Context(), old, moved)
+	require.NoError(t, err)
+}
+
+func TestMonitorCustomDefaulter_Default(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, pulseticv1.AddToScheme(scheme))
+
+	monitor := &pulseticv1.Monitor{ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "default"}}
+
+	d := &MonitorCustomDefaulter{Client: fake.NewClientBuilder().WithScheme(scheme).Build()}
+	require.NoError(t, d.Default(t.Context(), monitor))
+	assert.Empty(t, monitor.Spec.Account.Name)
+
+	d.Client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&pulseticv1.Account{
+			ObjectMeta: metav1.ObjectMeta{Name: "example"},
+			Spec:       pulseticv1.AccountSpec{IsDefault: true},
+		},
+	).WithIndex(&pulseticv1.Account{}, "spec.isDefault", func(obj client.Object) []string {
+		if obj.(*pulseticv1.Account).Spec.IsDefault { //nolint:errcheck
+			return []string{"true"}
+		}
+		return nil
+	}).Build()
+	require.NoError(t, d.Default(t.Context(), monitor))
+	assert.Equal(t, "example", monitor.Spec.Account.Name)
+}
+
+func TestMonitorCustomValidator_UnsupportedValuePolicy(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, pulseticv1.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&pulseticv1.Account{ObjectMeta: metav1.ObjectMeta{Name: "example"}},
+	).Build()
+	v := &MonitorCustomValidator{Client: c}
+
+	monitor := &pulseticv1.Monitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "example", Namespace: "default"},
+		Spec: pulseticv1.MonitorSpec{
+			Account: corev1.LocalObjectReference{Name: "example"},
+			Monitor: pulseticv1.MonitorValues{
+				URL: "https://example.com",
+				MonitorDefaults: pulseticv1.MonitorDefaults{
+					Interval: &metav1.Duration{Duration: 45 * time.Second},
+				},
+			},
+			UnsupportedValuePolicy: pulseticv1.UnsupportedValuePolicySnap,
+		},
+	}
+	warnings, err := v.ValidateCreate(t.Context(), monitor)
+	require.NoError(t, err)
+	assert.Len(t, warnings, 1)
+
+	monitor.Spec.UnsupportedValuePolicy = pulseticv1.UnsupportedValuePolicyReject
+	_, err = v.ValidateCreate(t.Context(), monitor)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "spec.monitor.interval")
+}